@@ -0,0 +1,106 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import "fmt"
+
+// A DayCount is a day count convention, which determines the fraction of a
+// year between two dates for the accrual of interest.
+type DayCount int
+
+const (
+	// Thirty360 is the 30/360 Bond Basis convention of the 2006 ISDA
+	// Definitions: months have 30 days, and a 31st is treated as the 30th
+	// unless it ends a period starting on neither the 30th nor the 31st.
+	Thirty360 DayCount = iota
+	// ThirtyE360 is the 30E/360 (Eurobond Basis) convention: months have 30
+	// days, and every 31st is treated as the 30th.
+	ThirtyE360
+	// Actual360 is the ACT/360 convention: actual days over 360.
+	Actual360
+	// Actual365Fixed is the ACT/365F convention: actual days over 365.
+	Actual365Fixed
+	// ActualActual is the ACT/ACT ISDA convention: the actual days in each
+	// calendar year of the period, over the number of days in that year.
+	ActualActual
+)
+
+// String returns the conventional name of the day count, such as "30/360"
+// or "ACT/365F".
+func (c DayCount) String() string {
+	switch c {
+	case Thirty360:
+		return "30/360"
+	case ThirtyE360:
+		return "30E/360"
+	case Actual360:
+		return "ACT/360"
+	case Actual365Fixed:
+		return "ACT/365F"
+	case ActualActual:
+		return "ACT/ACT"
+	}
+	return fmt.Sprintf("DayCount(%d)", int(c))
+}
+
+// Days returns the number of days from start to end under the convention:
+// the actual number for the ACT conventions, and the number in a calendar of
+// 30-day months for the 30/360 conventions. If end is before start, the
+// result is negative.
+func (c DayCount) Days(start, end Date) int {
+	if end.Before(start) {
+		return -c.Days(end, start)
+	}
+	d1, d2 := start.Day, end.Day
+	switch c {
+	case Thirty360:
+		if d1 == 31 {
+			d1 = 30
+		}
+		if d2 == 31 && d1 == 30 {
+			d2 = 30
+		}
+	case ThirtyE360:
+		d1, d2 = min(d1, 30), min(d2, 30)
+	default:
+		return end.DaysSince(start)
+	}
+	return 360*(end.Year-start.Year) + 30*(int(end.Month)-int(start.Month)) + d2 - d1
+}
+
+// Fraction returns the fraction of a year from start to end under the
+// convention. If end is before start, the result is negative.
+//
+// Fraction panics if c is not a known DayCount.
+func (c DayCount) Fraction(start, end Date) float64 {
+	if end.Before(start) {
+		return -c.Fraction(end, start)
+	}
+	switch c {
+	case Thirty360, ThirtyE360, Actual360:
+		return float64(c.Days(start, end)) / 360
+	case Actual365Fixed:
+		return float64(c.Days(start, end)) / 365
+	case ActualActual:
+		if start.Year == end.Year {
+			return float64(end.DaysSince(start)) / float64(Year(start.Year).Days())
+		}
+		next := Year(start.Year + 1).FirstDate()
+		f := float64(next.DaysSince(start)) / float64(Year(start.Year).Days())
+		f += float64(end.Year - start.Year - 1)
+		return f + float64(end.DaysSince(Year(end.Year).FirstDate()))/float64(Year(end.Year).Days())
+	}
+	panic(fmt.Sprintf("civil: unknown day count %v", c))
+}
@@ -0,0 +1,100 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDayCountDays(t *testing.T) {
+	// Examples after the 2006 ISDA Definitions, section 4.16.
+	for _, test := range []struct {
+		start, end   Date
+		thirty, euro int
+	}{
+		{Date{2007, 1, 15}, Date{2007, 1, 30}, 15, 15},
+		{Date{2007, 1, 15}, Date{2007, 2, 15}, 30, 30},
+		{Date{2007, 1, 15}, Date{2007, 7, 15}, 180, 180},
+		{Date{2007, 9, 30}, Date{2008, 3, 31}, 180, 180},
+		{Date{2007, 9, 30}, Date{2007, 10, 31}, 30, 30},
+		{Date{2007, 9, 30}, Date{2008, 9, 30}, 360, 360},
+		{Date{2007, 1, 15}, Date{2007, 1, 31}, 16, 15},
+		{Date{2007, 1, 31}, Date{2007, 2, 28}, 28, 28},
+		{Date{2007, 2, 28}, Date{2007, 3, 31}, 33, 32},
+		{Date{2006, 8, 31}, Date{2007, 2, 28}, 178, 178},
+		{Date{2008, 2, 29}, Date{2009, 2, 28}, 359, 359},
+		{Date{2007, 3, 31}, Date{2007, 3, 31}, 0, 0},
+	} {
+		if got := Thirty360.Days(test.start, test.end); got != test.thirty {
+			t.Errorf("Thirty360.Days(%v, %v) = %d, want %d", test.start, test.end, got, test.thirty)
+		}
+		if got := ThirtyE360.Days(test.start, test.end); got != test.euro {
+			t.Errorf("ThirtyE360.Days(%v, %v) = %d, want %d", test.start, test.end, got, test.euro)
+		}
+		if got := Thirty360.Days(test.end, test.start); got != -test.thirty {
+			t.Errorf("Thirty360.Days(%v, %v) = %d, want %d", test.end, test.start, got, -test.thirty)
+		}
+		want := test.end.DaysSince(test.start)
+		for _, c := range []DayCount{Actual360, Actual365Fixed, ActualActual} {
+			if got := c.Days(test.start, test.end); got != want {
+				t.Errorf("%v.Days(%v, %v) = %d, want %d", c, test.start, test.end, got, want)
+			}
+		}
+	}
+}
+
+func TestDayCountFraction(t *testing.T) {
+	for _, test := range []struct {
+		c          DayCount
+		start, end Date
+		want       float64
+	}{
+		// The ISDA example of a period from November 1, 2003 to May 1,
+		// 2004: 182 days, 61 of them in 2003.
+		{ActualActual, Date{2003, 11, 1}, Date{2004, 5, 1}, 61.0/365 + 121.0/366},
+		{Actual365Fixed, Date{2003, 11, 1}, Date{2004, 5, 1}, 182.0 / 365},
+		{Actual360, Date{2003, 11, 1}, Date{2004, 5, 1}, 182.0 / 360},
+		{Thirty360, Date{2003, 11, 1}, Date{2004, 5, 1}, 0.5},
+		{ActualActual, Date{2004, 1, 1}, Date{2004, 12, 31}, 365.0 / 366},
+		{ActualActual, Date{2003, 7, 1}, Date{2006, 7, 1}, 184.0/365 + 2 + 181.0/365},
+		{ActualActual, Date{2024, 1, 1}, Date{2025, 1, 1}, 1},
+		{Thirty360, Date{2007, 9, 30}, Date{2008, 3, 31}, 0.5},
+		{ThirtyE360, Date{2007, 2, 28}, Date{2007, 3, 31}, 32.0 / 360},
+		{Actual360, Date{2004, 5, 1}, Date{2003, 11, 1}, -182.0 / 360},
+	} {
+		if got := test.c.Fraction(test.start, test.end); math.Abs(got-test.want) > 1e-12 {
+			t.Errorf("%v.Fraction(%v, %v) = %v, want %v", test.c, test.start, test.end, got, test.want)
+		}
+	}
+	if got, want := ActualActual.Fraction(Date{2003, 11, 1}, Date{2004, 5, 1}), 0.497724380567; math.Abs(got-want) > 1e-12 {
+		t.Errorf("ActualActual.Fraction(2003-11-01, 2004-05-01) = %v, want %v", got, want)
+	}
+}
+
+func TestDayCountString(t *testing.T) {
+	for c, want := range map[DayCount]string{
+		Thirty360:      "30/360",
+		ThirtyE360:     "30E/360",
+		Actual360:      "ACT/360",
+		Actual365Fixed: "ACT/365F",
+		ActualActual:   "ACT/ACT",
+		DayCount(9):    "DayCount(9)",
+	} {
+		if got := c.String(); got != want {
+			t.Errorf("DayCount(%d).String() = %q, want %q", int(c), got, want)
+		}
+	}
+}
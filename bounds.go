@@ -0,0 +1,63 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import "fmt"
+
+// Bounds specifies whether the start and end of an interval are included
+// in it.
+type Bounds int
+
+const (
+	// Closed includes both endpoints: [start, end].
+	Closed Bounds = iota
+	// Open excludes both endpoints: (start, end).
+	Open
+	// ClosedOpen includes the start but not the end: [start, end).
+	ClosedOpen
+	// OpenClosed includes the end but not the start: (start, end].
+	OpenClosed
+)
+
+// String returns the interval notation for b, such as "[)" for ClosedOpen.
+func (b Bounds) String() string {
+	switch b {
+	case Closed:
+		return "[]"
+	case Open:
+		return "()"
+	case ClosedOpen:
+		return "[)"
+	case OpenClosed:
+		return "(]"
+	}
+	return fmt.Sprintf("Bounds(%d)", int(b))
+}
+
+// contains reports whether a value lies within an interval with bounds b,
+// given the results of comparing the value with the start and the end.
+func (b Bounds) contains(cmpStart, cmpEnd int) bool {
+	if b == Closed || b == ClosedOpen {
+		if cmpStart < 0 {
+			return false
+		}
+	} else if cmpStart <= 0 {
+		return false
+	}
+	if b == Closed || b == OpenClosed {
+		return cmpEnd <= 0
+	}
+	return cmpEnd < 0
+}
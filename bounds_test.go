@@ -0,0 +1,76 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import "testing"
+
+func TestIsBetween(t *testing.T) {
+	start, end := Date{2024, 1, 10}, Date{2024, 1, 20}
+	for _, test := range []struct {
+		d                                    Date
+		closed, open, closedOpen, openClosed bool
+	}{
+		{Date{2024, 1, 9}, false, false, false, false},
+		{start, true, false, true, false},
+		{Date{2024, 1, 15}, true, true, true, true},
+		{end, true, false, false, true},
+		{Date{2024, 1, 21}, false, false, false, false},
+	} {
+		for b, want := range map[Bounds]bool{Closed: test.closed, Open: test.open, ClosedOpen: test.closedOpen, OpenClosed: test.openClosed} {
+			if got := test.d.IsBetween(start, end, b); got != want {
+				t.Errorf("%v.IsBetween(%v, %v, %v) = %t, want %t", test.d, start, end, b, got, want)
+			}
+			dt := DateTime{Date: test.d}
+			if got := dt.IsBetween(DateTime{Date: start}, DateTime{Date: end}, b); got != want {
+				t.Errorf("%v.IsBetween(%v, %v, %v) = %t, want %t", dt, start, end, b, got, want)
+			}
+			tm := Time{Hour: test.d.Day}
+			if got := tm.IsBetween(Time{Hour: start.Day}, Time{Hour: end.Day}, b); got != want {
+				t.Errorf("%v.IsBetween(%v, %v, %v) = %t, want %t", tm, start, end, b, got, want)
+			}
+		}
+	}
+}
+
+func TestCompare(t *testing.T) {
+	for _, test := range []struct {
+		a, b DateTime
+		want int
+	}{
+		{DateTime{Date{2024, 1, 1}, Time{}}, DateTime{Date{2024, 1, 1}, Time{}}, 0},
+		{DateTime{Date{2024, 1, 1}, Time{Hour: 23}}, DateTime{Date{2024, 1, 2}, Time{}}, -1},
+		{DateTime{Date{2024, 1, 1}, Time{Nanosecond: 2}}, DateTime{Date{2024, 1, 1}, Time{Nanosecond: 1}}, +1},
+		{DateTime{Date{2023, 12, 31}, Time{}}, DateTime{Date{2024, 1, 1}, Time{}}, -1},
+	} {
+		if got := test.a.Compare(test.b); got != test.want {
+			t.Errorf("%v.Compare(%v) = %d, want %d", test.a, test.b, got, test.want)
+		}
+		if test.a.Date == test.b.Date {
+			if got := test.a.Time.Compare(test.b.Time); got != test.want {
+				t.Errorf("%v.Compare(%v) = %d, want %d", test.a.Time, test.b.Time, got, test.want)
+			}
+		} else if got := test.a.Date.Compare(test.b.Date); got != test.want {
+			t.Errorf("%v.Compare(%v) = %d, want %d", test.a.Date, test.b.Date, got, test.want)
+		}
+	}
+}
+
+func TestBoundsString(t *testing.T) {
+	for b, want := range map[Bounds]string{Closed: "[]", Open: "()", ClosedOpen: "[)", OpenClosed: "(]", Bounds(4): "Bounds(4)"} {
+		if got := b.String(); got != want {
+			t.Errorf("Bounds(%d).String() = %q, want %q", int(b), got, want)
+		}
+	}
+}
@@ -0,0 +1,177 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"fmt"
+	"time"
+)
+
+// A BusinessCalendar describes the working days of a business: every date
+// that is neither a weekend day nor a holiday.
+//
+// A nil *BusinessCalendar, like the zero BusinessCalendar, treats Saturday
+// and Sunday as the weekend and has no holidays.
+type BusinessCalendar struct {
+	// Weekend is the set of days of the week that are not working days.
+	// The empty set means SaturdaySunday; set NoWeekend for a calendar in
+	// which every day of the week is a working day.
+	Weekend WeekdaySet
+
+	// NoWeekend, if set, makes every day of the week a working day. Weekend
+	// is then ignored.
+	NoWeekend bool
+
+	// Holidays lists additional dates that are not working days.
+	Holidays []Date
+
+	// HolidayCalendar, if non-nil, supplies further holidays, such as those
+	// of a calendar registered with RegisterHolidayCalendar.
+	HolidayCalendar HolidayCalendar
+}
+
+// NewBusinessCalendar returns a BusinessCalendar with the given weekend,
+// which may be empty for a calendar with no weekend, and holidays. It
+// returns an error if every day of the week is in weekend, since such a
+// calendar has no working days, or if a holiday is not a valid date.
+func NewBusinessCalendar(weekend WeekdaySet, holidays ...Date) (*BusinessCalendar, error) {
+	if weekend.Len() == 7 {
+		return nil, fmt.Errorf("civil: business calendar with weekend %v has no working days", weekend)
+	}
+	for _, h := range holidays {
+		if !h.IsValid() {
+			return nil, fmt.Errorf("civil: business calendar has invalid holiday %v", h)
+		}
+	}
+	return &BusinessCalendar{Weekend: weekend, NoWeekend: weekend == 0, Holidays: holidays}, nil
+}
+
+// IsWeekend reports whether d falls on one of the days of the week in
+// weekend, such as SaturdaySunday or FridaySaturday.
+func (d Date) IsWeekend(weekend WeekdaySet) bool {
+	return weekend.Contains(d.Weekday())
+}
+
+// IsWeekend reports whether d falls on a weekend day of the calendar. It
+// ignores holidays.
+func (c *BusinessCalendar) IsWeekend(d Date) bool {
+	return d.IsWeekend(c.weekend())
+}
+
+// IsWorkingDay reports whether d is a working day in the calendar.
+func (c *BusinessCalendar) IsWorkingDay(d Date) bool {
+	if c.IsWeekend(d) {
+		return false
+	}
+	if c == nil {
+		return true
+	}
+	for _, h := range c.Holidays {
+		if d == h {
+			return false
+		}
+	}
+	return c.HolidayCalendar == nil || !c.HolidayCalendar.IsHoliday(d)
+}
+
+// weekend returns the days of the weekend of c.
+func (c *BusinessCalendar) weekend() WeekdaySet {
+	switch {
+	case c == nil:
+		return SaturdaySunday
+	case c.NoWeekend:
+		return 0
+	case c.Weekend == 0:
+		return SaturdaySunday
+	}
+	return c.Weekend
+}
+
+// LastWorkingDayOfMonth returns the last working day in the given month
+// according to c, or the zero Date if the month has no working days.
+func (c *BusinessCalendar) LastWorkingDayOfMonth(year int, month time.Month) Date {
+	ym := YearMonth{Year: year, Month: month}
+	for d := ym.LastDate(); d.Month == month; d = d.AddDays(-1) {
+		if c.IsWorkingDay(d) {
+			return d
+		}
+	}
+	return Date{}
+}
+
+// WorkingDaysInMonth returns the number of working days in the given month
+// according to cal.
+func WorkingDaysInMonth(year int, month time.Month, cal *BusinessCalendar) int {
+	start := Date{Year: year, Month: month, Day: 1}
+	return cal.countWorkingDays(start, start.AddMonths(1))
+}
+
+// WorkingDaysInYear returns the number of working days in the given year
+// according to cal.
+func WorkingDaysInYear(year int, cal *BusinessCalendar) int {
+	start := Date{Year: year, Month: time.January, Day: 1}
+	return cal.countWorkingDays(start, Date{Year: year + 1, Month: time.January, Day: 1})
+}
+
+// countWorkingDays returns the number of working days in [start, end).
+func (c *BusinessCalendar) countWorkingDays(start, end Date) int {
+	n := 0
+	for d := start; d.Before(end); d = d.AddDays(1) {
+		if c.IsWorkingDay(d) {
+			n++
+		}
+	}
+	return n
+}
+
+// AddBusinessDays returns the date that is n working days after d according
+// to cal, skipping weekends and holidays. If n is negative, it counts back
+// from d. The result is a working day unless n is zero, in which case it is
+// d.
+//
+// AddBusinessDays panics if n is nonzero and every day of the week is in
+// the weekend of cal, which NewBusinessCalendar does not allow. It also
+// panics if the count would pass MinDate or MaxDate, as it does when the
+// holidays of cal cover every working day that remains.
+func (d Date) AddBusinessDays(n int, cal *BusinessCalendar) Date {
+	if n != 0 && cal.weekend().Len() == 7 {
+		panic("civil: AddBusinessDays with a calendar that has no working days")
+	}
+	step, limit := 1, MaxDate
+	if n < 0 {
+		step, n, limit = -1, -n, MinDate
+	}
+	for n > 0 {
+		if d == limit {
+			panic("civil: AddBusinessDays out of the range of dates")
+		}
+		d = d.AddDays(step)
+		if cal.IsWorkingDay(d) {
+			n--
+		}
+	}
+	return d
+}
+
+// BusinessDaysBetween returns the number of working days according to cal
+// after a, up to and including b, so that if b is a.AddBusinessDays(n) for
+// positive n, the result is n. If b is before a, the result is the negated
+// number of working days after b up to and including a.
+func BusinessDaysBetween(a, b Date, cal *BusinessCalendar) int {
+	if b.Before(a) {
+		return -BusinessDaysBetween(b, a, cal)
+	}
+	return cal.countWorkingDays(a.AddDays(1), b.AddDays(1))
+}
@@ -0,0 +1,208 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBusinessCalendarWeekend(t *testing.T) {
+	sat, sun, mon, fri := Date{2024, 6, 1}, Date{2024, 6, 2}, Date{2024, 6, 3}, Date{2024, 6, 7}
+	for _, test := range []struct {
+		name    string
+		cal     *BusinessCalendar
+		weekend []Date
+		working []Date
+	}{
+		{"nil", nil, []Date{sat, sun}, []Date{mon, fri}},
+		{"zero", &BusinessCalendar{}, []Date{sat, sun}, []Date{mon, fri}},
+		{"FridaySaturday", &BusinessCalendar{Weekend: FridaySaturday}, []Date{fri, sat}, []Date{sun, mon}},
+		{"NoWeekend", &BusinessCalendar{NoWeekend: true}, nil, []Date{sat, sun, mon, fri}},
+		{"NoWeekend with Weekend", &BusinessCalendar{Weekend: SaturdaySunday, NoWeekend: true}, nil, []Date{sat, sun}},
+		{"holidays", &BusinessCalendar{Holidays: []Date{mon}}, []Date{sat, sun}, []Date{fri}},
+	} {
+		for _, d := range test.weekend {
+			if !test.cal.IsWeekend(d) || test.cal.IsWorkingDay(d) {
+				t.Errorf("%s: %v: IsWeekend = %t, IsWorkingDay = %t, want true, false", test.name, d, test.cal.IsWeekend(d), test.cal.IsWorkingDay(d))
+			}
+		}
+		for _, d := range test.working {
+			if !test.cal.IsWorkingDay(d) {
+				t.Errorf("%s: %v.IsWorkingDay = false, want true", test.name, d)
+			}
+		}
+	}
+	if cal := (WeekInfo{}).BusinessCalendar(); !cal.IsWorkingDay(sat) {
+		t.Errorf("WeekInfo{}.BusinessCalendar().IsWorkingDay(%v) = false, want true", sat)
+	}
+	if cal := RegionWeekInfo("SA").BusinessCalendar(); !cal.IsWeekend(fri) || cal.IsWeekend(sun) {
+		t.Errorf("the weekend of Saudi Arabia is not Friday and Saturday")
+	}
+}
+
+func TestDateIsWeekend(t *testing.T) {
+	// 2024-06-07 is a Friday.
+	for _, test := range []struct {
+		d       Date
+		weekend WeekdaySet
+		want    bool
+	}{
+		{Date{2024, 6, 7}, SaturdaySunday, false},
+		{Date{2024, 6, 8}, SaturdaySunday, true},
+		{Date{2024, 6, 9}, SaturdaySunday, true},
+		{Date{2024, 6, 7}, FridaySaturday, true},
+		{Date{2024, 6, 8}, FridaySaturday, true},
+		{Date{2024, 6, 9}, FridaySaturday, false},
+		{Date{2024, 6, 8}, 0, false},
+		{Date{2024, 6, 10}, WeekdaysOf(time.Monday), true},
+	} {
+		if got := test.d.IsWeekend(test.weekend); got != test.want {
+			t.Errorf("%v.IsWeekend(%v) = %t, want %t", test.d, test.weekend, got, test.want)
+		}
+	}
+}
+
+func TestWorkingDays(t *testing.T) {
+	cal := &BusinessCalendar{Holidays: []Date{{2024, 12, 25}, {2024, 12, 26}}}
+	for _, test := range []struct {
+		cal   *BusinessCalendar
+		year  int
+		month time.Month
+		want  int
+		last  Date
+	}{
+		{nil, 2024, time.February, 21, Date{2024, 2, 29}},
+		{nil, 2024, time.March, 21, Date{2024, 3, 29}},
+		{nil, 2024, time.June, 20, Date{2024, 6, 28}},
+		{cal, 2024, time.December, 20, Date{2024, 12, 31}},
+		{&BusinessCalendar{Holidays: []Date{{2024, 5, 31}}}, 2024, time.May, 22, Date{2024, 5, 30}},
+		{&BusinessCalendar{NoWeekend: true}, 2024, time.February, 29, Date{2024, 2, 29}},
+		{&BusinessCalendar{Weekend: WeekdaysOf(time.Sunday)}, 2024, time.June, 25, Date{2024, 6, 29}},
+	} {
+		if got := WorkingDaysInMonth(test.year, test.month, test.cal); got != test.want {
+			t.Errorf("WorkingDaysInMonth(%d, %v, %+v) = %d, want %d", test.year, test.month, test.cal, got, test.want)
+		}
+		if got := test.cal.LastWorkingDayOfMonth(test.year, test.month); got != test.last {
+			t.Errorf("%+v.LastWorkingDayOfMonth(%d, %v) = %v, want %v", test.cal, test.year, test.month, got, test.last)
+		}
+	}
+	if got, want := WorkingDaysInYear(2024, nil), 262; got != want {
+		t.Errorf("WorkingDaysInYear(2024, nil) = %d, want %d", got, want)
+	}
+	if got, want := WorkingDaysInYear(2024, cal), 260; got != want {
+		t.Errorf("WorkingDaysInYear(2024, %+v) = %d, want %d", cal, got, want)
+	}
+}
+
+func TestNewBusinessCalendar(t *testing.T) {
+	cal, err := NewBusinessCalendar(FridaySaturday, Date{2024, 4, 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cal.IsWorkingDay(Date{2024, 4, 12}) || cal.IsWorkingDay(Date{2024, 4, 10}) || !cal.IsWorkingDay(Date{2024, 4, 14}) {
+		t.Errorf("NewBusinessCalendar(%v, 2024-04-10) = %+v, wrong working days", FridaySaturday, cal)
+	}
+	if cal, err := NewBusinessCalendar(0); err != nil || !cal.IsWorkingDay(Date{2024, 6, 1}) {
+		t.Errorf("NewBusinessCalendar(0) = %+v, %v, want a calendar without weekend", cal, err)
+	}
+	for _, test := range []struct {
+		weekend  WeekdaySet
+		holidays []Date
+	}{
+		{WeekdaysOf(time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday), nil},
+		{SaturdaySunday, []Date{{2023, 2, 29}}},
+		{SaturdaySunday, []Date{{}}},
+	} {
+		if cal, err := NewBusinessCalendar(test.weekend, test.holidays...); err == nil {
+			t.Errorf("NewBusinessCalendar(%v, %v) = %+v, want error", test.weekend, test.holidays, cal)
+		}
+	}
+}
+
+func TestAddBusinessDays(t *testing.T) {
+	cal := &BusinessCalendar{Holidays: []Date{{2024, 12, 25}, {2024, 12, 26}, {2025, 1, 1}}}
+	for _, test := range []struct {
+		d    Date
+		n    int
+		cal  *BusinessCalendar
+		want Date
+	}{
+		{Date{2024, 6, 7}, 0, nil, Date{2024, 6, 7}},
+		{Date{2024, 6, 8}, 0, nil, Date{2024, 6, 8}},
+		{Date{2024, 6, 7}, 1, nil, Date{2024, 6, 10}},
+		{Date{2024, 6, 8}, 1, nil, Date{2024, 6, 10}},
+		{Date{2024, 6, 10}, -1, nil, Date{2024, 6, 7}},
+		{Date{2024, 6, 3}, 10, nil, Date{2024, 6, 17}},
+		{Date{2024, 12, 24}, 1, cal, Date{2024, 12, 27}},
+		{Date{2024, 12, 24}, 4, cal, Date{2025, 1, 2}},
+		{Date{2025, 1, 2}, -4, cal, Date{2024, 12, 24}},
+		{Date{2024, 6, 6}, 1, &BusinessCalendar{Weekend: FridaySaturday}, Date{2024, 6, 9}},
+		{Date{2024, 6, 7}, 1, &BusinessCalendar{NoWeekend: true}, Date{2024, 6, 8}},
+	} {
+		got := test.d.AddBusinessDays(test.n, test.cal)
+		if got != test.want {
+			t.Errorf("%v.AddBusinessDays(%d, %+v) = %v, want %v", test.d, test.n, test.cal, got, test.want)
+		}
+		if test.n != 0 {
+			if n := BusinessDaysBetween(test.d, got, test.cal); n != test.n {
+				t.Errorf("BusinessDaysBetween(%v, %v, %+v) = %d, want %d", test.d, got, test.cal, n, test.n)
+			}
+		}
+	}
+}
+
+func TestAddBusinessDaysPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("AddBusinessDays with a seven-day weekend did not panic")
+		}
+	}()
+	cal := &BusinessCalendar{Weekend: WeekdaysOf(time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday)}
+	Date{2024, 1, 1}.AddBusinessDays(1, cal)
+}
+
+// everyDay is a HolidayCalendar in which every date is a holiday.
+type everyDay struct{}
+
+func (everyDay) IsHoliday(Date) bool { return true }
+
+func (everyDay) HolidaysIn(int) []Date { return nil }
+
+func TestAddBusinessDaysOutOfRange(t *testing.T) {
+	for _, test := range []struct {
+		d   Date
+		n   int
+		cal *BusinessCalendar
+	}{
+		{Date{9999, 12, 1}, 1, &BusinessCalendar{HolidayCalendar: everyDay{}}},
+		{Date{1, 1, 31}, -1, &BusinessCalendar{HolidayCalendar: everyDay{}}},
+		{MaxDate, 1, nil},
+		{MinDate, -1, nil},
+		{Date{9999, 12, 29}, 3, nil},
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%v.AddBusinessDays(%d, %+v) did not panic", test.d, test.n, test.cal)
+				}
+			}()
+			test.d.AddBusinessDays(test.n, test.cal)
+		}()
+	}
+	if got, want := (Date{9999, 12, 29}).AddBusinessDays(2, nil), MaxDate; got != want {
+		t.Errorf("AddBusinessDays to MaxDate = %v, want %v", got, want)
+	}
+}
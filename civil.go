@@ -22,7 +22,9 @@
 package civil
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 )
 
@@ -36,6 +38,18 @@ type Date struct {
 	Day   int        // Day of the month, starting at 1.
 }
 
+// MinDate and MaxDate are the earliest and latest dates supported by the
+// RFC3339 full-date format: 0001-01-01 and 9999-12-31. They can be used as
+// open-ended bounds in range queries.
+var (
+	MinDate = Date{Year: 1, Month: time.January, Day: 1}
+	MaxDate = Date{Year: 9999, Month: time.December, Day: 31}
+)
+
+// ErrOutOfRange is returned by checked arithmetic when the result would fall
+// outside the range [MinDate, MaxDate].
+var ErrOutOfRange = errors.New("civil: result out of range")
+
 // DateOf returns the Date in which a time occurs in that time's location.
 func DateOf(t time.Time) Date {
 	var d Date
@@ -45,7 +59,7 @@ func DateOf(t time.Time) Date {
 
 // ParseDate parses a string in RFC3339 full-date format and returns the date value it represents.
 func ParseDate(s string) (Date, error) {
-	t, err := time.Parse("2006-01-02", s)
+	t, err := time.Parse(DateLayout, s)
 	if err != nil {
 		return Date{}, err
 	}
@@ -62,6 +76,19 @@ func (d Date) IsValid() bool {
 	return DateOf(d.In(time.UTC)) == d
 }
 
+// Normalize returns the valid date that d denotes, normalizing out-of-range
+// fields as time.Date does: month 13 is January of the following year, and
+// October 32 is November 1.
+func (d Date) Normalize() Date {
+	return DateOf(d.In(time.UTC))
+}
+
+// IsCanonical reports whether d is already normalized, so that
+// d.Normalize() == d. It is equivalent to IsValid.
+func (d Date) IsCanonical() bool {
+	return d.Normalize() == d
+}
+
 // In returns the time corresponding to time 00:00:00 of the date in the location.
 //
 // In is always consistent with time.Date, even when time.Date returns a time
@@ -76,12 +103,162 @@ func (d Date) In(loc *time.Location) time.Time {
 	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, loc)
 }
 
+// Weekday returns the day of the week specified by d.
+func (d Date) Weekday() time.Weekday {
+	return d.In(time.UTC).Weekday()
+}
+
+// YearDay returns the day of the year specified by d, in the range [1,365]
+// for non-leap years, and [1,366] in leap years.
+func (d Date) YearDay() int {
+	return d.In(time.UTC).YearDay()
+}
+
+// DayOfYear returns the day of the year specified by d, as described in
+// YearDay. Together with the year it forms the ISO 8601 ordinal date; see
+// DateFromOrdinal for the inverse.
+func (d Date) DayOfYear() int {
+	return d.YearDay()
+}
+
+// ISOWeek returns the ISO 8601 year and week number in which d occurs.
+// Week ranges from 1 to 53. Jan 01 to Jan 03 of year n might belong to
+// week 52 or 53 of year n-1, and Dec 29 to Dec 31 might belong to week 1
+// of year n+1.
+func (d Date) ISOWeek() (year, week int) {
+	// The week belongs to the year in which its Thursday falls.
+	days := daysFromCivil(d.Year, d.Month, d.Day)
+	thursday := days - floorMod(days+3, 7) + 3
+	year = d.Year + floorDiv(int(d.Month)-1, 12)
+	for thursday < daysFromCivil(year, time.January, 1) {
+		year--
+	}
+	for thursday >= daysFromCivil(year+1, time.January, 1) {
+		year++
+	}
+	return year, (thursday-daysFromCivil(year, time.January, 1))/7 + 1
+}
+
+// Quarter returns the calendar quarter in which d occurs.
+func (d Date) Quarter() Quarter {
+	return Quarter{Year: d.Year, Quarter: (int(d.Month)-1)/3 + 1}
+}
+
 // AddDays returns the date that is n days in the future.
 // n can also be negative to go into the past.
 func (d Date) AddDays(n int) Date {
 	return DateOf(d.In(time.UTC).AddDate(0, 0, n))
 }
 
+// AddDaysSaturating is like AddDays, but clamps the result to the range
+// [MinDate, MaxDate].
+func (d Date) AddDaysSaturating(n int) Date {
+	if n >= MaxDate.DaysSince(d) {
+		return MaxDate
+	}
+	if n <= MinDate.DaysSince(d) {
+		return MinDate
+	}
+	return d.AddDays(n)
+}
+
+// AddMonths returns the date that is n months in the future.
+// n can also be negative to go into the past.
+//
+// As with time.Time.AddDate, a day of the month that does not exist in the
+// resulting month is normalized: October 31 plus one month is December 1.
+func (d Date) AddMonths(n int) Date {
+	return DateOf(d.In(time.UTC).AddDate(0, n, 0))
+}
+
+// AddYears returns the date that is n years in the future.
+// n can also be negative to go into the past.
+//
+// As with AddMonths, February 29 in a year that is not a leap year is
+// normalized to March 1. Use AddYearsWithPolicy to choose otherwise.
+func (d Date) AddYears(n int) Date {
+	return DateOf(d.In(time.UTC).AddDate(n, 0, 0))
+}
+
+// AddWeeks returns the date that is n weeks in the future.
+// n can also be negative to go into the past.
+func (d Date) AddWeeks(n int) Date {
+	return d.AddDays(7 * n)
+}
+
+// AddQuarters returns the date that is n quarters, or 3n months, in the
+// future. n can also be negative to go into the past.
+//
+// As with AddMonths, a day of the month that does not exist in the
+// resulting month is normalized: November 30 plus one quarter is March 2
+// of a common year. Use AddMonthsClamped(3*n) to clamp it instead.
+func (d Date) AddQuarters(n int) Date {
+	return d.AddMonths(3 * n)
+}
+
+// AddMonthsClamped is like AddMonths, but a day of the month that does not
+// exist in the resulting month is clamped to the last day of that month:
+// January 31 plus one month is February 28 or 29, and October 31 plus one
+// month is November 30.
+func (d Date) AddMonthsClamped(n int) Date {
+	first := Date{Year: d.Year, Month: d.Month, Day: 1}.AddMonths(n)
+	last := YearMonth{Year: first.Year, Month: first.Month}.LastDate()
+	return Date{Year: first.Year, Month: first.Month, Day: min(d.Day, last.Day)}
+}
+
+// AddYearsClamped is like AddYears, but February 29 in a year that is not a
+// leap year is clamped to February 28.
+func (d Date) AddYearsClamped(n int) Date {
+	return d.AddMonthsClamped(12 * n)
+}
+
+// AddMonthsSaturating is like AddMonths, but clamps the result to the range
+// [MinDate, MaxDate].
+func (d Date) AddMonthsSaturating(n int) Date {
+	if n > MaxDate.monthIndex()-d.monthIndex() {
+		return MaxDate
+	}
+	if n < MinDate.monthIndex()-d.monthIndex() {
+		return MinDate
+	}
+	r := d.AddMonths(n)
+	if r.After(MaxDate) {
+		return MaxDate
+	}
+	if r.Before(MinDate) {
+		return MinDate
+	}
+	return r
+}
+
+// AddDaysChecked is like AddDays, but returns ErrOutOfRange if the result
+// falls outside the range [MinDate, MaxDate].
+func (d Date) AddDaysChecked(n int) (Date, error) {
+	if n > MaxDate.DaysSince(d) || n < MinDate.DaysSince(d) {
+		return Date{}, ErrOutOfRange
+	}
+	return d.AddDays(n), nil
+}
+
+// AddMonthsChecked is like AddMonths, but returns ErrOutOfRange if the
+// result falls outside the range [MinDate, MaxDate].
+func (d Date) AddMonthsChecked(n int) (Date, error) {
+	if n > MaxDate.monthIndex()-d.monthIndex() || n < MinDate.monthIndex()-d.monthIndex() {
+		return Date{}, ErrOutOfRange
+	}
+	r := d.AddMonths(n)
+	if r.After(MaxDate) || r.Before(MinDate) {
+		return Date{}, ErrOutOfRange
+	}
+	return r, nil
+}
+
+// monthIndex returns the number of months between the start of year 0 and
+// the start of the date's month.
+func (d Date) monthIndex() int {
+	return d.Year*12 + int(d.Month) - 1
+}
+
 // DaysSince returns the signed number of days between the date and s, not including the end day.
 // This is the inverse operation to AddDays.
 func (d Date) DaysSince(s Date) (days int) {
@@ -107,6 +284,24 @@ func (d1 Date) After(d2 Date) bool {
 	return d2.Before(d1)
 }
 
+// Compare compares d1 and d2. If d1 is before d2, it returns -1;
+// if d1 is after d2, it returns +1; if they're the same, it returns 0.
+func (d1 Date) Compare(d2 Date) int {
+	switch {
+	case d1.Before(d2):
+		return -1
+	case d1.After(d2):
+		return +1
+	}
+	return 0
+}
+
+// IsBetween reports whether d lies between start and end, with the
+// endpoints included or excluded according to b.
+func (d Date) IsBetween(start, end Date, b Bounds) bool {
+	return b.contains(d.Compare(start), d.Compare(end))
+}
+
 // IsZero reports whether date fields are set to their default value.
 func (d Date) IsZero() bool {
 	return (d.Year == 0) && (int(d.Month) == 0) && (d.Day == 0)
@@ -140,6 +335,19 @@ type Time struct {
 	Nanosecond int // The nanosecond of the second; range [0-999999999]
 }
 
+// MinTime and MaxTime are the earliest and latest times of day.
+var (
+	MinTime = Time{}
+	MaxTime = Time{Hour: 23, Minute: 59, Second: 59, Nanosecond: 999999999}
+)
+
+// EndOfDay is the ISO 8601 end-of-day time 24:00:00, the instant at which a
+// day ends and the next begins. It is not a valid Time, and sorts after
+// every valid one, but it may end a TimeRange, and a DateTime whose time is
+// EndOfDay normalizes to midnight at the start of the next day. Parser
+// accepts it if AllowEndOfDay is set.
+var EndOfDay = Time{Hour: 24}
+
 // TimeOf returns the Time representing the time of day in which a time occurs
 // in that time's location. It ignores the date.
 func TimeOf(t time.Time) Time {
@@ -149,19 +357,51 @@ func TimeOf(t time.Time) Time {
 	return tm
 }
 
+// NewTime returns the time of day with the given hour, minute, second and
+// nanosecond, or an error if any of them is out of range.
+func NewTime(hour, minute, second, nanosecond int) (Time, error) {
+	return Time{Hour: hour, Minute: minute, Second: second, Nanosecond: nanosecond}.checked()
+}
+
+// MustNewTime is like NewTime but panics if the time is not valid. It
+// simplifies the initialization of variables holding fixed times.
+func MustNewTime(hour, minute, second, nanosecond int) Time {
+	t, err := NewTime(hour, minute, second, nanosecond)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
 // ParseTime parses a string and returns the time value it represents.
 // ParseTime accepts an extended form of the RFC3339 partial-time format. After
 // the HH:MM:SS part of the string, an optional fractional part may appear,
 // consisting of a decimal point followed by one to nine decimal digits.
 // (RFC3339 admits only one digit after the decimal point).
+// As permitted by ISO 8601, a comma may be used in place of the decimal point.
 func ParseTime(s string) (Time, error) {
-	t, err := time.Parse("15:04:05.999999999", s)
+	t, err := time.Parse(TimeLayout, normalizeFraction(s))
 	if err != nil {
 		return Time{}, err
 	}
 	return TimeOf(t), nil
 }
 
+// normalizeFraction replaces a comma separating the seconds from the
+// fractional seconds with a decimal point, so that the comma form permitted
+// by ISO 8601 can be parsed with a layout that uses the decimal point.
+func normalizeFraction(s string) string {
+	i := strings.LastIndexByte(s, ',')
+	if i < 1 || i+1 >= len(s) || !isDigit(s[i-1]) || !isDigit(s[i+1]) {
+		return s
+	}
+	return s[:i] + "." + s[i+1:]
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
 // String returns the date in the format described in ParseTime. If Nanoseconds
 // is zero, no fractional part will be generated. Otherwise, the result will
 // end with a fractional part consisting of a decimal point and nine digits.
@@ -180,6 +420,53 @@ func (t Time) IsValid() bool {
 	return TimeOf(tm) == t
 }
 
+// IsEndOfDay reports whether t is EndOfDay, 24:00:00.
+func (t Time) IsEndOfDay() bool {
+	return t == EndOfDay
+}
+
+// Before reports whether t1 occurs before t2.
+func (t1 Time) Before(t2 Time) bool {
+	if t1.Hour != t2.Hour {
+		return t1.Hour < t2.Hour
+	}
+	if t1.Minute != t2.Minute {
+		return t1.Minute < t2.Minute
+	}
+	if t1.Second != t2.Second {
+		return t1.Second < t2.Second
+	}
+	return t1.Nanosecond < t2.Nanosecond
+}
+
+// After reports whether t1 occurs after t2.
+func (t1 Time) After(t2 Time) bool {
+	return t2.Before(t1)
+}
+
+// Compare compares t1 and t2. If t1 is before t2, it returns -1;
+// if t1 is after t2, it returns +1; if they're the same, it returns 0.
+func (t1 Time) Compare(t2 Time) int {
+	switch {
+	case t1.Before(t2):
+		return -1
+	case t1.After(t2):
+		return +1
+	}
+	return 0
+}
+
+// IsBetween reports whether t lies between start and end, with the
+// endpoints included or excluded according to b.
+func (t Time) IsBetween(start, end Time, b Bounds) bool {
+	return b.contains(t.Compare(start), t.Compare(end))
+}
+
+// IsMidnight reports whether t is 00:00:00, the start of the day.
+func (t Time) IsMidnight() bool {
+	return t == Time{}
+}
+
 // IsZero reports whether time fields are set to their default value.
 func (t Time) IsZero() bool {
 	return (t.Hour == 0) && (t.Minute == 0) && (t.Second == 0) && (t.Nanosecond == 0)
@@ -208,6 +495,13 @@ type DateTime struct {
 	Time Time
 }
 
+// MinDateTime and MaxDateTime are the earliest and latest datetimes with
+// dates in the range [MinDate, MaxDate].
+var (
+	MinDateTime = DateTime{Date: MinDate, Time: MinTime}
+	MaxDateTime = DateTime{Date: MaxDate, Time: MaxTime}
+)
+
 // Note: We deliberately do not embed Date into DateTime, to avoid promoting AddDays and Sub.
 
 // DateTimeOf returns the DateTime in which a time occurs in that time's location.
@@ -218,16 +512,41 @@ func DateTimeOf(t time.Time) DateTime {
 	}
 }
 
+// NewDateTime returns the DateTime of the given date and time, or an error
+// if either is not valid.
+func NewDateTime(d Date, t Time) (DateTime, error) {
+	d, err := d.checked()
+	if err != nil {
+		return DateTime{}, err
+	}
+	t, err = t.checked()
+	if err != nil {
+		return DateTime{}, err
+	}
+	return DateTime{Date: d, Time: t}, nil
+}
+
+// MustNewDateTime is like NewDateTime but panics if the date or time is not
+// valid.
+func MustNewDateTime(d Date, t Time) DateTime {
+	dt, err := NewDateTime(d, t)
+	if err != nil {
+		panic(err)
+	}
+	return dt
+}
+
 // ParseDateTime parses a string and returns the DateTime it represents.
 // ParseDateTime accepts a variant of the RFC3339 date-time format that omits
 // the time offset but includes an optional fractional time, as described in
 // ParseTime. Informally, the accepted format is
 //     YYYY-MM-DDTHH:MM:SS[.FFFFFFFFF]
-// where the 'T' may be a lower-case 't'.
+// where the 'T' may be a lower-case 't', and the decimal point may be a comma.
 func ParseDateTime(s string) (DateTime, error) {
-	t, err := time.Parse("2006-01-02T15:04:05.999999999", s)
+	s = normalizeFraction(s)
+	t, err := time.Parse(DateTimeLayout, s)
 	if err != nil {
-		t, err = time.Parse("2006-01-02t15:04:05.999999999", s)
+		t, err = time.Parse(DateLayout+"t"+TimeLayout, s)
 		if err != nil {
 			return DateTime{}, err
 		}
@@ -245,6 +564,19 @@ func (dt DateTime) IsValid() bool {
 	return dt.Date.IsValid() && dt.Time.IsValid()
 }
 
+// Normalize returns the valid datetime that dt denotes, normalizing
+// out-of-range fields as time.Date does: hour 24 is midnight at the start
+// of the next day, and a negative minute borrows from the hour.
+func (dt DateTime) Normalize() DateTime {
+	return DateTimeOf(dt.In(time.UTC))
+}
+
+// IsCanonical reports whether dt is already normalized, so that
+// dt.Normalize() == dt. It is equivalent to IsValid.
+func (dt DateTime) IsCanonical() bool {
+	return dt.Normalize() == dt
+}
+
 // In returns the time corresponding to the DateTime in the given location.
 //
 // If the time is missing or ambigous at the location, In returns the same
@@ -262,6 +594,78 @@ func (dt DateTime) In(loc *time.Location) time.Time {
 	return time.Date(dt.Date.Year, dt.Date.Month, dt.Date.Day, dt.Time.Hour, dt.Time.Minute, dt.Time.Second, dt.Time.Nanosecond, loc)
 }
 
+// Weekday returns the day of the week of the date of dt.
+func (dt DateTime) Weekday() time.Weekday {
+	return dt.Date.Weekday()
+}
+
+// YearDay returns the day of the year of the date of dt, as described in
+// Date.YearDay.
+func (dt DateTime) YearDay() int {
+	return dt.Date.YearDay()
+}
+
+// ISOWeek returns the ISO 8601 year and week number of the date of dt, as
+// described in Date.ISOWeek.
+func (dt DateTime) ISOWeek() (year, week int) {
+	return dt.Date.ISOWeek()
+}
+
+// Quarter returns the calendar quarter of the date of dt.
+func (dt DateTime) Quarter() Quarter {
+	return dt.Date.Quarter()
+}
+
+// IsStartOfDay reports whether the time of dt is midnight, as is the case
+// for a datetime that represents just a date.
+func (dt DateTime) IsStartOfDay() bool {
+	return dt.Time.IsMidnight()
+}
+
+// TruncateToDay returns midnight at the start of the date of dt.
+func (dt DateTime) TruncateToDay() DateTime {
+	return DateTime{Date: dt.Date}
+}
+
+// Add returns the datetime that is d after dt. Every day is taken to be 24
+// hours long, as in UTC, so the result is the wall-clock time d later
+// regardless of any daylight saving transitions; time carried past
+// midnight moves the date. dt.Time must be valid.
+func (dt DateTime) Add(d time.Duration) DateTime {
+	t, days := dt.Time.Add(d)
+	return DateTime{Date: dt.Date.AddDays(days), Time: t}
+}
+
+// Sub returns the duration dt1-dt2, with every day taken to be 24 hours
+// long, as for Add. If the result exceeds the maximum (or minimum) value
+// that can be stored in a Duration, the maximum (or minimum) duration is
+// returned.
+func (dt1 DateTime) Sub(dt2 DateTime) time.Duration {
+	return dt1.In(time.UTC).Sub(dt2.In(time.UTC))
+}
+
+// AddDaysChecked returns the datetime that is n days after dt, with the same
+// time of day, or ErrOutOfRange if the date falls outside the range
+// [MinDate, MaxDate].
+func (dt DateTime) AddDaysChecked(n int) (DateTime, error) {
+	d, err := dt.Date.AddDaysChecked(n)
+	if err != nil {
+		return DateTime{}, err
+	}
+	return DateTime{Date: d, Time: dt.Time}, nil
+}
+
+// AddMonthsChecked returns the datetime that is n months after dt, with the
+// same time of day, or ErrOutOfRange if the date falls outside the range
+// [MinDate, MaxDate]. The date is normalized as described in Date.AddMonths.
+func (dt DateTime) AddMonthsChecked(n int) (DateTime, error) {
+	d, err := dt.Date.AddMonthsChecked(n)
+	if err != nil {
+		return DateTime{}, err
+	}
+	return DateTime{Date: d, Time: dt.Time}, nil
+}
+
 // Before reports whether dt1 occurs before dt2.
 func (dt1 DateTime) Before(dt2 DateTime) bool {
 	return dt1.In(time.UTC).Before(dt2.In(time.UTC))
@@ -272,6 +676,33 @@ func (dt1 DateTime) After(dt2 DateTime) bool {
 	return dt2.Before(dt1)
 }
 
+// Compare compares dt1 and dt2. If dt1 is before dt2, it returns -1;
+// if dt1 is after dt2, it returns +1; if they're the same, it returns 0.
+func (dt1 DateTime) Compare(dt2 DateTime) int {
+	switch {
+	case dt1.Before(dt2):
+		return -1
+	case dt1.After(dt2):
+		return +1
+	}
+	return 0
+}
+
+// EqualAt reports whether dt1 and dt2 are equal when both are truncated to
+// a multiple of precision, as described in Time.Truncate. For example, with
+// a precision of time.Millisecond it ignores the microseconds and
+// nanoseconds lost in a round trip through a database that stores
+// milliseconds. precision should divide 24 hours.
+func (dt1 DateTime) EqualAt(dt2 DateTime, precision time.Duration) bool {
+	return dt1.Date == dt2.Date && dt1.Time.EqualAt(dt2.Time, precision)
+}
+
+// IsBetween reports whether dt lies between start and end, with the
+// endpoints included or excluded according to b.
+func (dt DateTime) IsBetween(start, end DateTime, b Bounds) bool {
+	return b.contains(dt.Compare(start), dt.Compare(end))
+}
+
 // IsZero reports whether datetime fields are set to their default value.
 func (dt DateTime) IsZero() bool {
 	return dt.Date.IsZero() && dt.Time.IsZero()
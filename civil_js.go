@@ -0,0 +1,76 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build js && wasm
+
+package civil
+
+import (
+	"syscall/js"
+	"time"
+)
+
+// JavaScript Date objects have millisecond precision, and all conversions in
+// this file use the Date's local components, as returned by getFullYear,
+// getHours and so on, rather than its UTC components.
+
+// DateOfJS returns the Date of the JavaScript Date object v in the local
+// time zone of the JavaScript environment.
+func DateOfJS(v js.Value) Date {
+	return Date{
+		Year:  v.Call("getFullYear").Int(),
+		Month: time.Month(v.Call("getMonth").Int() + 1),
+		Day:   v.Call("getDate").Int(),
+	}
+}
+
+// TimeOfJS returns the Time of the JavaScript Date object v in the local
+// time zone of the JavaScript environment. It ignores the date.
+func TimeOfJS(v js.Value) Time {
+	return Time{
+		Hour:       v.Call("getHours").Int(),
+		Minute:     v.Call("getMinutes").Int(),
+		Second:     v.Call("getSeconds").Int(),
+		Nanosecond: v.Call("getMilliseconds").Int() * int(time.Millisecond),
+	}
+}
+
+// DateTimeOfJS returns the DateTime of the JavaScript Date object v in the
+// local time zone of the JavaScript environment.
+func DateTimeOfJS(v js.Value) DateTime {
+	return DateTime{
+		Date: DateOfJS(v),
+		Time: TimeOfJS(v),
+	}
+}
+
+// JSDate returns a new JavaScript Date object representing midnight at the
+// start of the date in the local time zone of the JavaScript environment.
+func (d Date) JSDate() js.Value {
+	return DateTime{Date: d}.JSDate()
+}
+
+// JSDate returns a new JavaScript Date object whose local components match
+// the datetime. The nanoseconds are truncated to milliseconds.
+//
+// If the datetime is missing or ambiguous in the local time zone, the result
+// is resolved according to the rules of the JavaScript environment.
+func (dt DateTime) JSDate() js.Value {
+	// Set the components individually: the Date constructor maps years
+	// 0 through 99 to 1900 through 1999.
+	v := js.Global().Get("Date").New(2000, 0, 1)
+	v.Call("setFullYear", dt.Date.Year, int(dt.Date.Month)-1, dt.Date.Day)
+	v.Call("setHours", dt.Time.Hour, dt.Time.Minute, dt.Time.Second, dt.Time.Nanosecond/int(time.Millisecond))
+	return v
+}
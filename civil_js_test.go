@@ -0,0 +1,42 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build js && wasm
+
+package civil
+
+import "testing"
+
+func TestJSDateRoundTrip(t *testing.T) {
+	for _, dt := range []DateTime{
+		{Date{2024, 2, 29}, Time{13, 45, 30, 123000000}},
+		{Date{1970, 1, 1}, Time{}},
+		// The Date constructor maps these years to 1900 through 1999.
+		{Date{99, 12, 31}, Time{23, 59, 59, 0}},
+		{Date{1, 1, 1}, Time{}},
+	} {
+		v := dt.JSDate()
+		if got := DateTimeOfJS(v); got != dt {
+			t.Errorf("DateTimeOfJS(%v.JSDate()) = %v", dt, got)
+		}
+		if got := DateOfJS(dt.Date.JSDate()); got != dt.Date {
+			t.Errorf("DateOfJS(%v.JSDate()) = %v", dt.Date, got)
+		}
+	}
+	// Nanoseconds are truncated to milliseconds.
+	dt := DateTime{Date{2024, 1, 1}, Time{0, 0, 0, 1999999}}
+	if got, want := TimeOfJS(dt.JSDate()), (Time{Nanosecond: 1000000}); got != want {
+		t.Errorf("TimeOfJS(%v.JSDate()) = %v, want %v", dt, got, want)
+	}
+}
@@ -0,0 +1,460 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseDate(t *testing.T) {
+	for _, test := range []struct {
+		str  string
+		want Date // if empty, expect an error
+	}{
+		{"2016-01-02", Date{2016, 1, 2}},
+		{"2016-12-31", Date{2016, 12, 31}},
+		{"0003-02-04", Date{3, 2, 4}},
+		{"999-01-26", Date{}},
+		{"", Date{}},
+		{"2016-01-02x", Date{}},
+		{"2016-02-30", Date{}},
+	} {
+		got, err := ParseDate(test.str)
+		if got != test.want {
+			t.Errorf("ParseDate(%q) = %+v, want %+v", test.str, got, test.want)
+		}
+		if err != nil && test.want != (Date{}) {
+			t.Errorf("Unexpected error %v from ParseDate(%q)", err, test.str)
+		}
+		if err == nil && got.String() != test.str {
+			t.Errorf("%v.String() = %q, want %q", got, got.String(), test.str)
+		}
+	}
+}
+
+func TestParseTime(t *testing.T) {
+	for _, test := range []struct {
+		str  string
+		want Time
+		ok   bool
+	}{
+		{"00:00:00", Time{}, true},
+		{"08:09:10", Time{8, 9, 10, 0}, true},
+		{"08:09:10.5", Time{8, 9, 10, 500000000}, true},
+		{"08:09:10.123456789", Time{8, 9, 10, 123456789}, true},
+		// ISO 8601 permits a comma in place of the decimal point.
+		{"08:09:10,5", Time{8, 9, 10, 500000000}, true},
+		{"23:59:59,000000001", Time{23, 59, 59, 1}, true},
+		{"08:09:10,", Time{}, false},
+		{"08:09,10", Time{}, false},
+		{"24:00:00", Time{}, false},
+		{"", Time{}, false},
+	} {
+		got, err := ParseTime(test.str)
+		if (err == nil) != test.ok || got != test.want {
+			t.Errorf("ParseTime(%q) = %+v, %v, want %+v, ok %t", test.str, got, err, test.want, test.ok)
+		}
+	}
+}
+
+func TestTimeString(t *testing.T) {
+	for _, test := range []struct {
+		t    Time
+		want string
+	}{
+		{Time{}, "00:00:00"},
+		{Time{8, 9, 10, 0}, "08:09:10"},
+		{Time{8, 9, 10, 500000000}, "08:09:10.500000000"},
+		{Time{23, 59, 59, 1}, "23:59:59.000000001"},
+	} {
+		if got := test.t.String(); got != test.want {
+			t.Errorf("%#v.String() = %q, want %q", test.t, got, test.want)
+		}
+		if back, err := ParseTime(test.want); err != nil || back != test.t {
+			t.Errorf("ParseTime(%q) = %+v, %v, want %+v", test.want, back, err, test.t)
+		}
+	}
+}
+
+func TestParseDateTime(t *testing.T) {
+	for _, test := range []struct {
+		str  string
+		want DateTime
+		ok   bool
+	}{
+		{"2016-03-22T13:26:33", DateTime{Date{2016, 3, 22}, Time{13, 26, 33, 0}}, true},
+		{"2016-03-22t13:26:33", DateTime{Date{2016, 3, 22}, Time{13, 26, 33, 0}}, true},
+		{"2016-03-22T13:26:33.000000600", DateTime{Date{2016, 3, 22}, Time{13, 26, 33, 600}}, true},
+		{"2016-03-22T13:26:33,25", DateTime{Date{2016, 3, 22}, Time{13, 26, 33, 250000000}}, true},
+		{"2016-03-22 13:26:33", DateTime{}, false},
+		{"2016-03-22T13:26:33Z", DateTime{}, false},
+		{"2016-03-22", DateTime{}, false},
+	} {
+		got, err := ParseDateTime(test.str)
+		if (err == nil) != test.ok || got != test.want {
+			t.Errorf("ParseDateTime(%q) = %+v, %v, want %+v, ok %t", test.str, got, err, test.want, test.ok)
+		}
+		if err == nil {
+			if back, err := ParseDateTime(got.String()); err != nil || back != got {
+				t.Errorf("ParseDateTime(%q) = %+v, %v, want %+v", got.String(), back, err, got)
+			}
+		}
+	}
+}
+
+func TestUnmarshalTextComma(t *testing.T) {
+	var tm Time
+	if err := tm.UnmarshalText([]byte("12:00:00,75")); err != nil || tm != (Time{12, 0, 0, 750000000}) {
+		t.Errorf("UnmarshalText(12:00:00,75) = %+v, %v", tm, err)
+	}
+	var dt DateTime
+	if err := dt.UnmarshalText([]byte("2024-01-02T12:00:00,75")); err != nil || dt.Time != tm {
+		t.Errorf("UnmarshalText(2024-01-02T12:00:00,75) = %+v, %v", dt, err)
+	}
+}
+
+func TestDateAddMonths(t *testing.T) {
+	for _, test := range []struct {
+		d    Date
+		n    int
+		want Date
+	}{
+		{Date{2024, 1, 15}, 1, Date{2024, 2, 15}},
+		{Date{2024, 1, 31}, 1, Date{2024, 3, 2}},
+		{Date{2023, 1, 31}, 1, Date{2023, 3, 3}},
+		{Date{2024, 10, 31}, 1, Date{2024, 12, 1}},
+		{Date{2024, 3, 31}, -1, Date{2024, 3, 2}},
+		{Date{2024, 12, 15}, 1, Date{2025, 1, 15}},
+		{Date{2024, 1, 15}, -13, Date{2022, 12, 15}},
+		{Date{2024, 2, 29}, 12, Date{2025, 3, 1}},
+	} {
+		if got := test.d.AddMonths(test.n); got != test.want {
+			t.Errorf("%v.AddMonths(%d) = %v, want %v", test.d, test.n, got, test.want)
+		}
+	}
+}
+
+func TestDateSaturating(t *testing.T) {
+	for _, test := range []struct {
+		d            Date
+		n            int
+		days, months Date
+	}{
+		{Date{2024, 1, 1}, 1, Date{2024, 1, 2}, Date{2024, 2, 1}},
+		{MaxDate, 1, MaxDate, MaxDate},
+		{MinDate, -1, MinDate, MinDate},
+		{Date{9999, 12, 1}, 31, MaxDate, MaxDate},
+		{Date{2024, 1, 1}, 1 << 40, MaxDate, MaxDate},
+		{Date{2024, 1, 1}, -1 << 40, MinDate, MinDate},
+		{Date{9999, 11, 30}, 1, Date{9999, 12, 1}, Date{9999, 12, 30}},
+		{Date{9999, 10, 31}, 2, Date{9999, 11, 2}, MaxDate},
+	} {
+		if got := test.d.AddDaysSaturating(test.n); got != test.days {
+			t.Errorf("%v.AddDaysSaturating(%d) = %v, want %v", test.d, test.n, got, test.days)
+		}
+		if got := test.d.AddMonthsSaturating(test.n); got != test.months {
+			t.Errorf("%v.AddMonthsSaturating(%d) = %v, want %v", test.d, test.n, got, test.months)
+		}
+	}
+	if !MinDate.IsValid() || !MaxDate.IsValid() || !MinDateTime.IsValid() || !MaxDateTime.IsValid() || !MaxTime.IsValid() {
+		t.Error("a Min or Max sentinel is not valid")
+	}
+}
+
+func TestDateChecked(t *testing.T) {
+	for _, test := range []struct {
+		d            Date
+		n            int
+		days, months Date // the zero Date for ErrOutOfRange
+	}{
+		{Date{2024, 1, 31}, 1, Date{2024, 2, 1}, Date{2024, 3, 2}},
+		{MaxDate, 0, MaxDate, MaxDate},
+		{MaxDate, 1, Date{}, Date{}},
+		{MinDate, -1, Date{}, Date{}},
+		{Date{9999, 11, 30}, 1, Date{9999, 12, 1}, Date{9999, 12, 30}},
+		{Date{9999, 12, 1}, 31, Date{}, Date{}},
+		{Date{2024, 1, 1}, 1 << 40, Date{}, Date{}},
+	} {
+		got, err := test.d.AddDaysChecked(test.n)
+		if got != test.days || (err != nil) != test.days.IsZero() || (err != nil && err != ErrOutOfRange) {
+			t.Errorf("%v.AddDaysChecked(%d) = %v, %v, want %v", test.d, test.n, got, err, test.days)
+		}
+		got, err = test.d.AddMonthsChecked(test.n)
+		if got != test.months || (err != nil) != test.months.IsZero() || (err != nil && err != ErrOutOfRange) {
+			t.Errorf("%v.AddMonthsChecked(%d) = %v, %v, want %v", test.d, test.n, got, err, test.months)
+		}
+	}
+	dt := DateTime{MaxDate, Time{Hour: 12}}
+	if _, err := dt.AddDaysChecked(1); err != ErrOutOfRange {
+		t.Errorf("%v.AddDaysChecked(1) error = %v, want ErrOutOfRange", dt, err)
+	}
+	if got, err := dt.AddMonthsChecked(-1); err != nil || got != (DateTime{Date{9999, 12, 1}, Time{Hour: 12}}) {
+		t.Errorf("%v.AddMonthsChecked(-1) = %v, %v", dt, got, err)
+	}
+}
+
+func TestStartOfDay(t *testing.T) {
+	for _, test := range []struct {
+		dt   DateTime
+		want bool
+	}{
+		{DateTime{Date{2024, 3, 10}, Time{}}, true},
+		{DateTime{Date{2024, 3, 10}, Time{0, 0, 0, 1}}, false},
+		{DateTime{Date{2024, 3, 10}, Time{0, 0, 1, 0}}, false},
+		{DateTime{Date{2024, 3, 10}, Time{23, 59, 59, 999999999}}, false},
+	} {
+		if got := test.dt.Time.IsMidnight(); got != test.want {
+			t.Errorf("%v.IsMidnight() = %t, want %t", test.dt.Time, got, test.want)
+		}
+		if got := test.dt.IsStartOfDay(); got != test.want {
+			t.Errorf("%v.IsStartOfDay() = %t, want %t", test.dt, got, test.want)
+		}
+		want := DateTime{Date: test.dt.Date}
+		if got := test.dt.TruncateToDay(); got != want || !got.IsStartOfDay() {
+			t.Errorf("%v.TruncateToDay() = %v, want %v", test.dt, got, want)
+		}
+	}
+}
+
+func TestCalendarAccessors(t *testing.T) {
+	for _, test := range []struct {
+		d       Date
+		weekday time.Weekday
+		yearDay int
+		isoYear int
+		isoWeek int
+		quarter int
+	}{
+		{Date{2024, 1, 1}, time.Monday, 1, 2024, 1, 1},
+		{Date{2024, 2, 29}, time.Thursday, 60, 2024, 9, 1},
+		{Date{2024, 4, 1}, time.Monday, 92, 2024, 14, 2},
+		{Date{2024, 12, 31}, time.Tuesday, 366, 2025, 1, 4},
+		{Date{2023, 12, 31}, time.Sunday, 365, 2023, 52, 4},
+		{Date{2021, 1, 3}, time.Sunday, 3, 2020, 53, 1},
+		{Date{2023, 9, 30}, time.Saturday, 273, 2023, 39, 3},
+	} {
+		dt := DateTime{test.d, Time{12, 0, 0, 0}}
+		if got := dt.Weekday(); got != test.weekday {
+			t.Errorf("%v.Weekday() = %v, want %v", dt, got, test.weekday)
+		}
+		if got := test.d.YearDay(); got != test.yearDay {
+			t.Errorf("%v.YearDay() = %d, want %d", test.d, got, test.yearDay)
+		}
+		if got := dt.YearDay(); got != test.yearDay {
+			t.Errorf("%v.YearDay() = %d, want %d", dt, got, test.yearDay)
+		}
+		if y, w := test.d.ISOWeek(); y != test.isoYear || w != test.isoWeek {
+			t.Errorf("%v.ISOWeek() = %d, %d, want %d, %d", test.d, y, w, test.isoYear, test.isoWeek)
+		}
+		if y, w := dt.ISOWeek(); y != test.isoYear || w != test.isoWeek {
+			t.Errorf("%v.ISOWeek() = %d, %d, want %d, %d", dt, y, w, test.isoYear, test.isoWeek)
+		}
+		want := Quarter{Year: test.d.Year, Quarter: test.quarter}
+		if got := test.d.Quarter(); got != want {
+			t.Errorf("%v.Quarter() = %v, want %v", test.d, got, want)
+		}
+		if got := dt.Quarter(); got != want {
+			t.Errorf("%v.Quarter() = %v, want %v", dt, got, want)
+		}
+	}
+}
+
+func TestDateClamped(t *testing.T) {
+	for _, test := range []struct {
+		d      Date
+		n      int
+		months Date // AddMonthsClamped(n)
+		years  Date // AddYearsClamped(n)
+	}{
+		{Date{2024, 1, 31}, 1, Date{2024, 2, 29}, Date{2025, 1, 31}},
+		{Date{2023, 1, 31}, 1, Date{2023, 2, 28}, Date{2024, 1, 31}},
+		{Date{2024, 10, 31}, 1, Date{2024, 11, 30}, Date{2025, 10, 31}},
+		{Date{2024, 3, 31}, -1, Date{2024, 2, 29}, Date{2023, 3, 31}},
+		{Date{2024, 2, 29}, 12, Date{2025, 2, 28}, Date{2036, 2, 29}},
+		{Date{2024, 2, 29}, -1, Date{2024, 1, 29}, Date{2023, 2, 28}},
+		{Date{2024, 5, 15}, 0, Date{2024, 5, 15}, Date{2024, 5, 15}},
+		{Date{2024, 12, 31}, 2, Date{2025, 2, 28}, Date{2026, 12, 31}},
+	} {
+		if got := test.d.AddMonthsClamped(test.n); got != test.months {
+			t.Errorf("%v.AddMonthsClamped(%d) = %v, want %v", test.d, test.n, got, test.months)
+		}
+		if got := test.d.AddYearsClamped(test.n); got != test.years {
+			t.Errorf("%v.AddYearsClamped(%d) = %v, want %v", test.d, test.n, got, test.years)
+		}
+	}
+}
+
+func TestDateAddWeeksQuarters(t *testing.T) {
+	for _, test := range []struct {
+		d     Date
+		n     int
+		weeks Date // AddWeeks(n)
+		qtrs  Date // AddQuarters(n)
+	}{
+		{Date{2024, 1, 1}, 0, Date{2024, 1, 1}, Date{2024, 1, 1}},
+		{Date{2024, 1, 1}, 1, Date{2024, 1, 8}, Date{2024, 4, 1}},
+		{Date{2024, 2, 26}, 1, Date{2024, 3, 4}, Date{2024, 5, 26}},
+		{Date{2024, 1, 1}, -1, Date{2023, 12, 25}, Date{2023, 10, 1}},
+		{Date{2024, 1, 1}, 52, Date{2024, 12, 30}, Date{2037, 1, 1}},
+		// AddQuarters normalizes as AddMonths does.
+		{Date{2023, 11, 30}, 1, Date{2023, 12, 7}, Date{2024, 3, 1}},
+		{Date{2024, 5, 31}, -1, Date{2024, 5, 24}, Date{2024, 3, 2}},
+	} {
+		if got := test.d.AddWeeks(test.n); got != test.weeks {
+			t.Errorf("%v.AddWeeks(%d) = %v, want %v", test.d, test.n, got, test.weeks)
+		}
+		if got := test.d.AddQuarters(test.n); got != test.qtrs {
+			t.Errorf("%v.AddQuarters(%d) = %v, want %v", test.d, test.n, got, test.qtrs)
+		}
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	for _, test := range []struct {
+		d, want Date
+	}{
+		{Date{2024, 2, 29}, Date{2024, 2, 29}},
+		{Date{2023, 2, 29}, Date{2023, 3, 1}},
+		{Date{2024, 13, 1}, Date{2025, 1, 1}},
+		{Date{2024, 10, 32}, Date{2024, 11, 1}},
+		{Date{2024, 1, 0}, Date{2023, 12, 31}},
+		{Date{2024, 0, 15}, Date{2023, 12, 15}},
+	} {
+		if got := test.d.Normalize(); got != test.want {
+			t.Errorf("%v.Normalize() = %v, want %v", test.d, got, test.want)
+		}
+		if got, want := test.d.IsCanonical(), test.d == test.want; got != want {
+			t.Errorf("%v.IsCanonical() = %t, want %t", test.d, got, want)
+		}
+	}
+	for _, test := range []struct {
+		dt, want DateTime
+	}{
+		{DateTime{Date{2024, 3, 10}, Time{12, 30, 0, 0}}, DateTime{Date{2024, 3, 10}, Time{12, 30, 0, 0}}},
+		{DateTime{Date{2024, 12, 31}, Time{24, 0, 0, 0}}, DateTime{Date{2025, 1, 1}, Time{}}},
+		{DateTime{Date{2024, 3, 10}, Time{12, -1, 0, 0}}, DateTime{Date{2024, 3, 10}, Time{11, 59, 0, 0}}},
+		{DateTime{Date{2024, 3, 10}, Time{0, 0, 0, -1}}, DateTime{Date{2024, 3, 9}, Time{23, 59, 59, 999999999}}},
+		{DateTime{Date{2024, 2, 30}, Time{0, 90, 0, 0}}, DateTime{Date{2024, 3, 1}, Time{1, 30, 0, 0}}},
+	} {
+		if got := test.dt.Normalize(); got != test.want {
+			t.Errorf("%v.Normalize() = %v, want %v", test.dt, got, test.want)
+		}
+		if got, want := test.dt.IsCanonical(), test.dt == test.want; got != want {
+			t.Errorf("%v.IsCanonical() = %t, want %t", test.dt, got, want)
+		}
+	}
+}
+
+func TestNewDateTime(t *testing.T) {
+	for _, test := range []struct {
+		h, m, s, ns int
+		ok          bool
+	}{
+		{0, 0, 0, 0, true},
+		{23, 59, 59, 999999999, true},
+		{24, 0, 0, 0, false},
+		{12, 60, 0, 0, false},
+		{12, 0, 60, 0, false},
+		{12, 0, 0, -1, false},
+	} {
+		want := Time{test.h, test.m, test.s, test.ns}
+		got, err := NewTime(test.h, test.m, test.s, test.ns)
+		if test.ok && (err != nil || got != want) {
+			t.Errorf("NewTime(%d, %d, %d, %d) = %v, %v, want %v", test.h, test.m, test.s, test.ns, got, err, want)
+		}
+		if !test.ok && err == nil {
+			t.Errorf("NewTime(%d, %d, %d, %d) = %v, want error", test.h, test.m, test.s, test.ns, got)
+		}
+	}
+	d, tm := Date{2024, 2, 29}, Time{Hour: 9}
+	if got, err := NewDateTime(d, tm); err != nil || got != (DateTime{d, tm}) {
+		t.Errorf("NewDateTime(%v, %v) = %v, %v", d, tm, got, err)
+	}
+	for _, test := range []struct {
+		d  Date
+		tm Time
+	}{
+		{Date{2023, 2, 29}, tm},
+		{d, Time{Hour: 25}},
+		{d, EndOfDay},
+	} {
+		if got, err := NewDateTime(test.d, test.tm); err == nil {
+			t.Errorf("NewDateTime(%v, %v) = %v, want error", test.d, test.tm, got)
+		}
+	}
+	if got := MustNewDateTime(d, MustNewTime(9, 0, 0, 0)); got != (DateTime{d, tm}) {
+		t.Errorf("MustNewDateTime(%v, 09:00) = %v", d, got)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Error("MustNewTime(24, 0, 0, 0) did not panic")
+		}
+	}()
+	MustNewTime(24, 0, 0, 0)
+}
+
+func TestDateTimeAdd(t *testing.T) {
+	dt := func(y int, mo time.Month, d, h, mi int) DateTime {
+		return DateTime{Date{y, mo, d}, Time{Hour: h, Minute: mi}}
+	}
+	for _, test := range []struct {
+		dt   DateTime
+		d    time.Duration
+		want DateTime
+	}{
+		{dt(2024, 3, 10, 9, 0), 90 * time.Minute, dt(2024, 3, 10, 10, 30)},
+		{dt(2024, 3, 10, 22, 0), 3 * time.Hour, dt(2024, 3, 11, 1, 0)},
+		{dt(2024, 3, 10, 1, 0), -2 * time.Hour, dt(2024, 3, 9, 23, 0)},
+		// Every day is 24 hours, whatever the daylight saving rules.
+		{dt(2024, 3, 9, 12, 0), 24 * time.Hour, dt(2024, 3, 10, 12, 0)},
+		{dt(2024, 2, 28, 12, 0), 36 * time.Hour, dt(2024, 3, 1, 0, 0)},
+		{dt(2024, 12, 31, 23, 30), time.Hour, dt(2025, 1, 1, 0, 30)},
+		{dt(2024, 1, 1, 0, 0), -1000 * 24 * time.Hour, dt(2021, 4, 6, 0, 0)},
+	} {
+		if got := test.dt.Add(test.d); got != test.want {
+			t.Errorf("%v.Add(%v) = %v, want %v", test.dt, test.d, got, test.want)
+		}
+	}
+}
+
+func TestDateTimeSub(t *testing.T) {
+	dt := func(y int, mo time.Month, d, h, mi int) DateTime {
+		return DateTime{Date{y, mo, d}, Time{Hour: h, Minute: mi}}
+	}
+	for _, test := range []struct {
+		dt1, dt2 DateTime
+		want     time.Duration
+	}{
+		{dt(2024, 3, 10, 10, 30), dt(2024, 3, 10, 9, 0), 90 * time.Minute},
+		{dt(2024, 3, 10, 9, 0), dt(2024, 3, 10, 10, 30), -90 * time.Minute},
+		{dt(2024, 3, 11, 1, 0), dt(2024, 3, 10, 22, 0), 3 * time.Hour},
+		{dt(2024, 3, 1, 0, 0), dt(2024, 2, 28, 0, 0), 48 * time.Hour},
+		{dt(2024, 3, 10, 9, 0), dt(2024, 3, 10, 9, 0), 0},
+		// Durations beyond about 292 years are clamped.
+		{DateTime{Date: MaxDate}, DateTime{Date: MinDate}, time.Duration(1<<63 - 1)},
+		{DateTime{Date: MinDate}, DateTime{Date: MaxDate}, time.Duration(-1 << 63)},
+	} {
+		got := test.dt1.Sub(test.dt2)
+		if got != test.want {
+			t.Errorf("%v.Sub(%v) = %v, want %v", test.dt1, test.dt2, got, test.want)
+		}
+		if test.want > -1<<63 && test.want < 1<<63-1 {
+			if back := test.dt2.Add(got); back != test.dt1 {
+				t.Errorf("%v.Add(%v) = %v, want %v", test.dt2, got, back, test.dt1)
+			}
+		}
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// A CJKStyle selects the characters used to format dates and times in the
+// style of East Asian documents.
+type CJKStyle int
+
+const (
+	// JapaneseStyle formats as "2020年2月29日" and "3時42分31秒". The
+	// dates are also those of traditional Chinese.
+	JapaneseStyle CJKStyle = iota
+	// ChineseStyle formats as "2020年2月29日" and "3时42分31秒", with the
+	// simplified Chinese character for hour.
+	ChineseStyle
+	// KoreanStyle formats as "2020년 2월 29일" and "3시 42분 31초".
+	KoreanStyle
+)
+
+// cjkUnits maps the characters that follow each number of a CJK date or
+// time to the index of the field it sets: year, month, day, hour, minute
+// and second.
+var cjkUnits = map[rune]int{
+	'年': 0, '년': 0,
+	'月': 1, '월': 1,
+	'日': 2, '일': 2,
+	'時': 3, '时': 3, '시': 3,
+	'分': 4, '분': 4,
+	'秒': 5, '초': 5,
+}
+
+// FormatCJKDate formats d in the given style, without zero padding.
+func FormatCJKDate(d Date, style CJKStyle) string {
+	if style == KoreanStyle {
+		return fmt.Sprintf("%d년 %d월 %d일", d.Year, d.Month, d.Day)
+	}
+	return fmt.Sprintf("%d年%d月%d日", d.Year, d.Month, d.Day)
+}
+
+// FormatCJKTime formats t in the given style, without zero padding.
+// Nanoseconds are formatted as a fraction of the second, if non-zero.
+func FormatCJKTime(t Time, style CJKStyle) string {
+	sec := strconv.Itoa(t.Second)
+	if t.Nanosecond != 0 {
+		sec += strings.TrimRight(fmt.Sprintf(".%09d", t.Nanosecond), "0")
+	}
+	switch style {
+	case ChineseStyle:
+		return fmt.Sprintf("%d时%d分%s秒", t.Hour, t.Minute, sec)
+	case KoreanStyle:
+		return fmt.Sprintf("%d시 %d분 %s초", t.Hour, t.Minute, sec)
+	}
+	return fmt.Sprintf("%d時%d分%s秒", t.Hour, t.Minute, sec)
+}
+
+// FormatCJKDateTime formats dt in the given style, separating the date and
+// the time with a space.
+func FormatCJKDateTime(dt DateTime, style CJKStyle) string {
+	return FormatCJKDate(dt.Date, style) + " " + FormatCJKTime(dt.Time, style)
+}
+
+// ParseCJKDate parses a date in any of the CJK styles, such as
+// "2020年2月29日" or "2020년 2월 29일". Numbers need not be zero padded,
+// may use full-width digits, and may be separated by spaces.
+func ParseCJKDate(s string) (Date, error) {
+	f, err := parseCJK(s, 0, 3, 3)
+	if err != nil {
+		return Date{}, err
+	}
+	return validDate(s, f[0], time.Month(f[1]), f[2])
+}
+
+// ParseCJKTime parses a time in any of the CJK styles, such as "3時42分31秒",
+// "3时42分" or "3시 42분 31.5초". The minutes and seconds may be omitted,
+// and the seconds may have a fractional part.
+func ParseCJKTime(s string) (Time, error) {
+	f, err := parseCJK(s, 3, 1, 6)
+	if err != nil {
+		return Time{}, err
+	}
+	t := Time{Hour: f[3], Minute: f[4], Second: f[5], Nanosecond: f[6]}
+	if !t.IsValid() {
+		return Time{}, fmt.Errorf("civil: %q is not a valid time", s)
+	}
+	return t, nil
+}
+
+// ParseCJKDateTime parses a date followed by a time, as accepted by
+// ParseCJKDate and ParseCJKTime, such as "2020年2月29日 3時42分31秒".
+func ParseCJKDateTime(s string) (DateTime, error) {
+	f, err := parseCJK(s, 0, 4, 6)
+	if err != nil {
+		return DateTime{}, err
+	}
+	var dt DateTime
+	if dt.Date, err = validDate(s, f[0], time.Month(f[1]), f[2]); err != nil {
+		return DateTime{}, err
+	}
+	dt.Time = Time{Hour: f[3], Minute: f[4], Second: f[5], Nanosecond: f[6]}
+	if !dt.Time.IsValid() {
+		return DateTime{}, fmt.Errorf("civil: %q is not a valid time", s)
+	}
+	return dt, nil
+}
+
+// parseCJK parses a sequence of numbers, each followed by a unit character,
+// that sets the fields with indexes from first up to but not including
+// last, in order. At least the first required fields must be present. The
+// returned array holds the year, month, day, hour, minute, second and
+// nanosecond.
+func parseCJK(s string, first, required, last int) ([7]int, error) {
+	var f [7]int
+	bad := fmt.Errorf("civil: cannot parse %q", s)
+	rest := strings.TrimSpace(Parser{NormalizeDigits: true}.normalize(s))
+	next := first
+	for rest != "" {
+		i := strings.IndexFunc(rest, func(r rune) bool { return (r < '0' || r > '9') && r != '.' })
+		if i <= 0 || next >= last {
+			return f, bad
+		}
+		unit, size := utf8.DecodeRuneInString(rest[i:])
+		if field, ok := cjkUnits[unit]; !ok || field != next {
+			return f, bad
+		}
+		whole, frac, hasFrac := strings.Cut(rest[:i], ".")
+		if !isDigits(whole) || (hasFrac && (next != 5 || !isDigits(frac) || len(frac) > 9)) {
+			return f, bad
+		}
+		f[next], _ = strconv.Atoi(whole)
+		if hasFrac {
+			f[6], _ = strconv.Atoi(frac + strings.Repeat("0", 9-len(frac)))
+		}
+		next++
+		rest = strings.TrimSpace(rest[i+size:])
+	}
+	if next-first < required {
+		return f, bad
+	}
+	return f, nil
+}
@@ -0,0 +1,99 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import "testing"
+
+func TestCJKRoundTrip(t *testing.T) {
+	dt := DateTime{Date{2020, 2, 29}, Time{3, 42, 31, 500000000}}
+	for _, test := range []struct {
+		style      CJKStyle
+		date, time string
+	}{
+		{JapaneseStyle, "2020年2月29日", "3時42分31.5秒"},
+		{ChineseStyle, "2020年2月29日", "3时42分31.5秒"},
+		{KoreanStyle, "2020년 2월 29일", "3시 42분 31.5초"},
+	} {
+		if got := FormatCJKDate(dt.Date, test.style); got != test.date {
+			t.Errorf("FormatCJKDate(%v, %d) = %q, want %q", dt.Date, test.style, got, test.date)
+		}
+		if got := FormatCJKTime(dt.Time, test.style); got != test.time {
+			t.Errorf("FormatCJKTime(%v, %d) = %q, want %q", dt.Time, test.style, got, test.time)
+		}
+		s := FormatCJKDateTime(dt, test.style)
+		if want := test.date + " " + test.time; s != want {
+			t.Errorf("FormatCJKDateTime(%v, %d) = %q, want %q", dt, test.style, s, want)
+		}
+		if got, err := ParseCJKDate(test.date); err != nil || got != dt.Date {
+			t.Errorf("ParseCJKDate(%q) = %v, %v, want %v", test.date, got, err, dt.Date)
+		}
+		if got, err := ParseCJKTime(test.time); err != nil || got != dt.Time {
+			t.Errorf("ParseCJKTime(%q) = %v, %v, want %v", test.time, got, err, dt.Time)
+		}
+		if got, err := ParseCJKDateTime(s); err != nil || got != dt {
+			t.Errorf("ParseCJKDateTime(%q) = %v, %v, want %v", s, got, err, dt)
+		}
+	}
+	if got, want := FormatCJKTime(Time{15, 4, 5, 0}, JapaneseStyle), "15時4分5秒"; got != want {
+		t.Errorf("FormatCJKTime without nanoseconds = %q, want %q", got, want)
+	}
+}
+
+func TestParseCJK(t *testing.T) {
+	for _, test := range []struct {
+		s    string
+		want Date
+	}{
+		{"2020年02月09日", Date{2020, 2, 9}},
+		{"２０２０年２月２９日", Date{2020, 2, 29}},
+		{" 2020年 2月 29日 ", Date{2020, 2, 29}},
+		{"2020년2월29일", Date{2020, 2, 29}},
+	} {
+		if got, err := ParseCJKDate(test.s); err != nil || got != test.want {
+			t.Errorf("ParseCJKDate(%q) = %v, %v, want %v", test.s, got, err, test.want)
+		}
+	}
+	for _, test := range []struct {
+		s    string
+		want Time
+	}{
+		{"3時", Time{3, 0, 0, 0}},
+		{"3时42分", Time{3, 42, 0, 0}},
+		{"23시 59분 59.999999999초", Time{23, 59, 59, 999999999}},
+	} {
+		if got, err := ParseCJKTime(test.s); err != nil || got != test.want {
+			t.Errorf("ParseCJKTime(%q) = %v, %v, want %v", test.s, got, err, test.want)
+		}
+	}
+	if got, err := ParseCJKDateTime("2020年2月29日3時"); err != nil || got != (DateTime{Date{2020, 2, 29}, Time{3, 0, 0, 0}}) {
+		t.Errorf("ParseCJKDateTime(2020年2月29日3時) = %v, %v", got, err)
+	}
+
+	for _, s := range []string{"", "2020年2月", "2020年2月30日", "2月29日", "2020年29日", "2020月2年29日", "2020年2月29日3時", "2020.5年2月29日", "2020-02-29"} {
+		if got, err := ParseCJKDate(s); err == nil {
+			t.Errorf("ParseCJKDate(%q) = %v, want error", s, got)
+		}
+	}
+	for _, s := range []string{"", "24時", "3時60分", "3時42.5分", "42分", "3時42分31.秒", "3時42分31.1234567890秒"} {
+		if got, err := ParseCJKTime(s); err == nil {
+			t.Errorf("ParseCJKTime(%q) = %v, want error", s, got)
+		}
+	}
+	for _, s := range []string{"2020年2月29日", "3時42分31秒", "2020年2月29日 25時"} {
+		if got, err := ParseCJKDateTime(s); err == nil {
+			t.Errorf("ParseCJKDateTime(%q) = %v, want error", s, got)
+		}
+	}
+}
@@ -0,0 +1,303 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command civil validates, converts and does arithmetic on civil dates.
+//
+// Usage:
+//
+//	civil validate VALUE...
+//	civil convert [-from FORMAT] [-to FORMAT] DATE
+//	civil add DATE DELTA
+//	civil diff DATE1 DATE2
+//
+// The validate command reports whether each VALUE is a date, a time or a
+// datetime in the formats accepted by the civil package, and exits with a
+// non-zero status if any is not.
+//
+// The convert command converts DATE between formats. FORMAT is one of
+//
+//	iso    an RFC3339 full-date, such as 2020-02-29
+//	epoch  the number of days since 1970-01-01, such as 18321
+//	jdn    the Julian day number, such as 2458909
+//	week   an ISO 8601 week date, such as 2020-W09-6
+//
+// DATE is read in the -from format, iso by default. If -to is not given,
+// DATE is printed in every format.
+//
+// The add command adds DELTA to DATE. DELTA is a sequence of signed counts
+// of years (y), months (m), weeks (w) and days (d), such as 3m2d, -1y or
+// 1y-2d. Years and months are added first, then weeks and days. A day that
+// does not exist in the resulting month is normalized, so 2020-01-31 plus
+// 1m is 2020-03-02.
+//
+// The diff command prints the number of days from DATE1 to DATE2.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang-sql/civil"
+)
+
+// unixEpochJDN is the Julian day number of 1970-01-01, epoch day 0.
+const unixEpochJDN = 2440588
+
+var formats = []string{"iso", "epoch", "jdn", "week"}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+	var err error
+	switch cmd, args := os.Args[1], os.Args[2:]; cmd {
+	case "validate":
+		err = validate(args)
+	case "convert":
+		err = convert(args)
+	case "add":
+		err = add(args)
+	case "diff":
+		err = diff(args)
+	default:
+		usage()
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "civil: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	fmt.Fprint(os.Stderr, `usage: civil validate VALUE...
+       civil convert [-from FORMAT] [-to FORMAT] DATE
+       civil add DATE DELTA
+       civil diff DATE1 DATE2
+`)
+	os.Exit(2)
+}
+
+func validate(args []string) error {
+	if len(args) == 0 {
+		usage()
+	}
+	invalid := 0
+	for _, s := range args {
+		if _, err := civil.ParseDate(s); err == nil {
+			fmt.Printf("%s: date\n", s)
+		} else if _, err := civil.ParseTime(s); err == nil {
+			fmt.Printf("%s: time\n", s)
+		} else if _, err := civil.ParseDateTime(s); err == nil {
+			fmt.Printf("%s: datetime\n", s)
+		} else {
+			fmt.Printf("%s: invalid\n", s)
+			invalid++
+		}
+	}
+	if invalid > 0 {
+		return fmt.Errorf("%d invalid value(s)", invalid)
+	}
+	return nil
+}
+
+func convert(args []string) error {
+	fs := flag.NewFlagSet("convert", flag.ExitOnError)
+	from := fs.String("from", "iso", "input `format`")
+	to := fs.String("to", "", "output `format` (default all)")
+	fs.Parse(args)
+	if fs.NArg() != 1 {
+		usage()
+	}
+	d, err := parseDate(fs.Arg(0), *from)
+	if err != nil {
+		return err
+	}
+	if *to != "" {
+		s, err := formatDate(d, *to)
+		if err != nil {
+			return err
+		}
+		fmt.Println(s)
+		return nil
+	}
+	for _, f := range formats {
+		s, _ := formatDate(d, f)
+		fmt.Printf("%-6s %s\n", f, s)
+	}
+	return nil
+}
+
+func add(args []string) error {
+	if len(args) != 2 {
+		usage()
+	}
+	d, err := civil.ParseDate(args[0])
+	if err != nil {
+		return err
+	}
+	months, days, err := parseDelta(args[1])
+	if err != nil {
+		return err
+	}
+	r, err := d.AddMonthsChecked(months)
+	if err == nil {
+		r, err = r.AddDaysChecked(days)
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Println(r)
+	return nil
+}
+
+func diff(args []string) error {
+	if len(args) != 2 {
+		usage()
+	}
+	d1, err := civil.ParseDate(args[0])
+	if err != nil {
+		return err
+	}
+	d2, err := civil.ParseDate(args[1])
+	if err != nil {
+		return err
+	}
+	fmt.Println(d2.DaysSince(d1))
+	return nil
+}
+
+// parseDate parses s as a date in the named format.
+func parseDate(s, format string) (civil.Date, error) {
+	switch format {
+	case "iso":
+		return civil.ParseDate(s)
+	case "epoch", "jdn":
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return civil.Date{}, err
+		}
+		if format == "jdn" {
+			n -= unixEpochJDN
+		}
+		return civil.DateFromEpochDay(0).AddDaysChecked(n)
+	case "week":
+		return parseWeekDate(s)
+	}
+	return civil.Date{}, fmt.Errorf("unknown format %q", format)
+}
+
+// formatDate formats d in the named format.
+func formatDate(d civil.Date, format string) (string, error) {
+	switch format {
+	case "iso":
+		return d.String(), nil
+	case "epoch":
+		return strconv.Itoa(d.EpochDay()), nil
+	case "jdn":
+		return strconv.Itoa(d.EpochDay() + unixEpochJDN), nil
+	case "week":
+		year, week := d.ISOWeek()
+		return fmt.Sprintf("%04d-W%02d-%d", year, week, isoWeekday(d)), nil
+	}
+	return "", fmt.Errorf("unknown format %q", format)
+}
+
+// parseWeekDate parses an ISO 8601 week date of the form YYYY-Www-D.
+func parseWeekDate(s string) (civil.Date, error) {
+	if len(s) != 10 || s[4] != '-' || s[5] != 'W' || s[8] != '-' ||
+		!isDigits(s[:4]) || !isDigits(s[6:8]) || !isDigits(s[9:]) {
+		return civil.Date{}, fmt.Errorf("cannot parse %q as a week date", s)
+	}
+	year, _ := strconv.Atoi(s[:4])
+	week, _ := strconv.Atoi(s[6:8])
+	day := int(s[9] - '0')
+	if week < 1 || week > 53 || day < 1 || day > 7 {
+		return civil.Date{}, fmt.Errorf("cannot parse %q as a week date", s)
+	}
+	d := civil.FromISOWeek(year, week, time.Weekday(day%7))
+	if y, w := d.ISOWeek(); y != year || w != week {
+		return civil.Date{}, fmt.Errorf("%d has no week %d", year, week)
+	}
+	return d, nil
+}
+
+// isDigits reports whether s is a non-empty string of ASCII digits.
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
+
+// isoWeekday returns the ISO 8601 number of the day of the week of d,
+// from 1 for Monday to 7 for Sunday.
+func isoWeekday(d civil.Date) int {
+	return (int(d.Weekday())+6)%7 + 1
+}
+
+// parseDelta parses a calendar delta such as "1y-2m3d" into a number of
+// months and a number of days.
+func parseDelta(s string) (months, days int, err error) {
+	if s == "" {
+		return 0, 0, errors.New("empty delta")
+	}
+	rest := s
+	for rest != "" {
+		i := strings.IndexAny(rest, "ymwd")
+		if i < 0 {
+			return 0, 0, fmt.Errorf("cannot parse %q as a delta", s)
+		}
+		n, err := strconv.Atoi(rest[:i])
+		if err != nil {
+			return 0, 0, fmt.Errorf("cannot parse %q as a delta", s)
+		}
+		ok := false
+		switch rest[i] {
+		case 'y':
+			months, ok = addScaled(months, n, 12)
+		case 'm':
+			months, ok = addScaled(months, n, 1)
+		case 'w':
+			days, ok = addScaled(days, n, 7)
+		case 'd':
+			days, ok = addScaled(days, n, 1)
+		}
+		if !ok {
+			return 0, 0, fmt.Errorf("delta %q out of range", s)
+		}
+		rest = rest[i+1:]
+	}
+	return months, days, nil
+}
+
+// addScaled returns total + n*scale, for a positive scale, and reports
+// whether the result fits in an int.
+func addScaled(total, n, scale int) (int, bool) {
+	if n > math.MaxInt/scale || n < math.MinInt/scale {
+		return 0, false
+	}
+	n *= scale
+	if (n > 0 && total > math.MaxInt-n) || (n < 0 && total < math.MinInt-n) {
+		return 0, false
+	}
+	return total + n, true
+}
@@ -0,0 +1,109 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang-sql/civil"
+)
+
+func TestFormatsRoundTrip(t *testing.T) {
+	for _, test := range []struct {
+		d                   civil.Date
+		epoch, jdn, weekStr string
+	}{
+		{civil.Date{Year: 1970, Month: time.January, Day: 1}, "0", "2440588", "1970-W01-4"},
+		{civil.Date{Year: 2020, Month: time.February, Day: 29}, "18321", "2458909", "2020-W09-6"},
+		{civil.Date{Year: 2021, Month: time.January, Day: 3}, "18630", "2459218", "2020-W53-7"},
+		{civil.Date{Year: 2024, Month: time.December, Day: 30}, "20087", "2460675", "2025-W01-1"},
+		{civil.Date{Year: 1969, Month: time.December, Day: 31}, "-1", "2440587", "1970-W01-3"},
+	} {
+		for format, want := range map[string]string{
+			"iso":   test.d.String(),
+			"epoch": test.epoch,
+			"jdn":   test.jdn,
+			"week":  test.weekStr,
+		} {
+			s, err := formatDate(test.d, format)
+			if err != nil || s != want {
+				t.Errorf("formatDate(%v, %q) = %q, %v, want %q", test.d, format, s, err, want)
+				continue
+			}
+			if got, err := parseDate(s, format); err != nil || got != test.d {
+				t.Errorf("parseDate(%q, %q) = %v, %v, want %v", s, format, got, err, test.d)
+			}
+		}
+	}
+}
+
+func TestParseDateRejects(t *testing.T) {
+	for _, test := range []struct {
+		s, format string
+	}{
+		{"2020-02-30", "iso"},
+		{"x", "epoch"},
+		{"1.5", "jdn"},
+		{"2020-W54-1", "week"},
+		{"2021-W53-1", "week"},
+		{"2020-W09-8", "week"},
+		{"2020-W00-1", "week"},
+		{"2020-09-6", "week"},
+		{"2020-W09-6xyz", "week"},
+		{"2020-W09-67", "week"},
+		{"2020-W9-6", "week"},
+		{"+020-W09-6", "week"},
+		{"2020-W+9-6", "week"},
+		{"2020-w09-6", "week"},
+		{"2020-02-29", "rfc"},
+	} {
+		if got, err := parseDate(test.s, test.format); err == nil {
+			t.Errorf("parseDate(%q, %q) = %v, want error", test.s, test.format, got)
+		}
+	}
+	if _, err := formatDate(civil.Date{Year: 2020, Month: time.January, Day: 1}, "rfc"); err == nil {
+		t.Error("formatDate with an unknown format succeeded, want error")
+	}
+}
+
+func TestParseDelta(t *testing.T) {
+	for _, test := range []struct {
+		s            string
+		months, days int
+	}{
+		{"3m2d", 3, 2},
+		{"-1y", -12, 0},
+		{"1y-2d", 12, -2},
+		{"2w", 0, 14},
+		{"1y1m1w1d", 13, 8},
+		{"+1d", 0, 1},
+	} {
+		months, days, err := parseDelta(test.s)
+		if err != nil || months != test.months || days != test.days {
+			t.Errorf("parseDelta(%q) = %d, %d, %v, want %d, %d, nil", test.s, months, days, err, test.months, test.days)
+		}
+	}
+	for _, s := range []string{
+		"", "3", "m", "3x", "3m2", "1.5d", "d3",
+		// Counts that overflow.
+		"768614336404564651y", "-768614336404564651y", "1317624576693539402w",
+		"9223372036854775807m1m", "-9223372036854775808d-1d", "9223372036854775808d",
+	} {
+		if months, days, err := parseDelta(s); err == nil {
+			t.Errorf("parseDelta(%q) = %d, %d, want error", s, months, days)
+		}
+	}
+}
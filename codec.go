@@ -0,0 +1,362 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// An OffsetPolicy specifies how a Codec treats a UTC offset attached to a
+// value that is scanned into a civil type, such as a PostgreSQL timestamptz
+// rendered as text or a SQL Server datetimeoffset.
+type OffsetPolicy int
+
+const (
+	// RejectOffset reports an error for a value with a UTC offset.
+	RejectOffset OffsetPolicy = iota
+	// StripOffset discards the offset and keeps the wall-clock value.
+	StripOffset
+	// ConvertOffset converts the value through its offset to the wall-clock
+	// value in the Codec's Location.
+	ConvertOffset
+)
+
+// String returns the name of the policy, such as "StripOffset".
+func (p OffsetPolicy) String() string {
+	switch p {
+	case RejectOffset:
+		return "RejectOffset"
+	case StripOffset:
+		return "StripOffset"
+	case ConvertOffset:
+		return "ConvertOffset"
+	}
+	return fmt.Sprintf("OffsetPolicy(%d)", int(p))
+}
+
+// A RangeForm selects the JSON representation of a range.
+type RangeForm int
+
+const (
+	// IntervalForm represents a range as an ISO 8601 interval string, such
+	// as "2024-01-01/2024-01-31".
+	IntervalForm RangeForm = iota
+	// ObjectForm represents a range as an object, such as
+	// {"start":"2024-01-01","end":"2024-01-31"}.
+	ObjectForm
+)
+
+// A Codec converts civil values to and from their database and text
+// representations according to configurable policies, so that a schema can
+// map onto civil types deliberately. The zero Codec behaves like the methods
+// of the civil types, except that it also accepts a space between the date
+// and the time of a datetime.
+type Codec struct {
+	// Offsets specifies how a UTC offset on a scanned value is treated.
+	Offsets OffsetPolicy
+
+	// Location is the location into which ConvertOffset converts values.
+	// If nil, UTC is used.
+	Location *time.Location
+
+	// ReferenceDate is the date on which ConvertOffset converts a scanned
+	// Time, which has no date of its own, since the offset in effect in
+	// Location may change with daylight saving time. If zero, the current
+	// date in Location is used, so that the result of converting the same
+	// value can depend on the day it is scanned.
+	ReferenceDate Date
+
+	// DateTimeSeparator is the character written between the date and the
+	// time of a formatted datetime, such as ' ' for the form preferred by
+	// SQL tools. If zero, 'T' is used, as by DateTime.String. Either form
+	// is accepted on input regardless.
+	DateTimeSeparator byte
+
+	// RangeForm is the JSON representation in which ranges are marshaled
+	// and unmarshaled. Only that form is accepted on input; use a wrapper
+	// type such as DateRangeObject, TimeRangeObject or DateTimeRangeObject
+	// to accept either.
+	RangeForm RangeForm
+
+	// LeapSeconds specifies how a parsed or scanned time whose seconds are
+	// 60, such as 23:59:60, is treated.
+	LeapSeconds LeapSecondPolicy
+}
+
+// MarshalDateRange returns the JSON encoding of r in the form configured
+// on c.
+func (c Codec) MarshalDateRange(r DateRange) ([]byte, error) {
+	if c.RangeForm == ObjectForm {
+		return json.Marshal(DateRangeObject(r))
+	}
+	return json.Marshal(r)
+}
+
+// UnmarshalDateRange parses the JSON encoding of a range in the form
+// configured on c and stores the result in r.
+func (c Codec) UnmarshalDateRange(data []byte, r *DateRange) error {
+	if c.RangeForm == ObjectForm {
+		var o dateRangeObject
+		if err := json.Unmarshal(data, &o); err != nil {
+			return err
+		}
+		*r = DateRange(o)
+		return nil
+	}
+	return json.Unmarshal(data, r)
+}
+
+// MarshalTimeRange returns the JSON encoding of r in the form configured
+// on c.
+func (c Codec) MarshalTimeRange(r TimeRange) ([]byte, error) {
+	if c.RangeForm == ObjectForm {
+		return json.Marshal(TimeRangeObject(r))
+	}
+	return json.Marshal(r)
+}
+
+// UnmarshalTimeRange parses the JSON encoding of a range in the form
+// configured on c and stores the result in r.
+func (c Codec) UnmarshalTimeRange(data []byte, r *TimeRange) error {
+	if c.RangeForm == ObjectForm {
+		return (*TimeRangeObject)(r).unmarshalObject(data)
+	}
+	return json.Unmarshal(data, r)
+}
+
+// MarshalDateTimeRange returns the JSON encoding of r in the form
+// configured on c, with the datetimes formatted as by c.FormatDateTime.
+func (c Codec) MarshalDateTimeRange(r DateTimeRange) ([]byte, error) {
+	start, end := c.FormatDateTime(r.Start), c.FormatDateTime(r.End)
+	if c.RangeForm == ObjectForm {
+		return json.Marshal(dateTimeRangeObject{Start: start, End: end})
+	}
+	return json.Marshal(start + "/" + end)
+}
+
+// UnmarshalDateTimeRange parses the JSON encoding of a range in the form
+// configured on c, with the datetimes parsed as by c.ParseDateTime, and
+// stores the result in r. An interval string may also have one of the
+// forms with a duration accepted by ParseDateTimeRange.
+func (c Codec) UnmarshalDateTimeRange(data []byte, r *DateTimeRange) error {
+	var start, end string
+	if c.RangeForm == ObjectForm {
+		var o dateTimeRangeObject
+		if err := json.Unmarshal(data, &o); err != nil {
+			return err
+		}
+		start, end = o.Start, o.End
+	} else {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		var ok bool
+		start, end, ok = strings.Cut(s, "/")
+		if !ok || strings.HasPrefix(start, "P") || strings.HasPrefix(end, "P") {
+			rg, err := ParseDateTimeRange(s)
+			if err != nil {
+				return err
+			}
+			*r = rg
+			return nil
+		}
+	}
+	var rg DateTimeRange
+	var err error
+	if rg.Start, err = c.ParseDateTime(start); err != nil {
+		return err
+	}
+	if rg.End, err = c.ParseDateTime(end); err != nil {
+		return err
+	}
+	*r = rg
+	return nil
+}
+
+// FormatDateTime returns dt in the format of DateTime.String, using the
+// separator configured on c.
+func (c Codec) FormatDateTime(dt DateTime) string {
+	sep := c.DateTimeSeparator
+	if sep == 0 {
+		sep = 'T'
+	}
+	return dt.Date.String() + string(sep) + dt.Time.String()
+}
+
+// ParseDateTime parses a string in the format accepted by ParseDateTime,
+// except that the date and time may also be separated by a space, and a
+// leap second is treated according to c.LeapSeconds.
+func (c Codec) ParseDateTime(s string) (DateTime, error) {
+	if len(s) > 10 && s[10] == ' ' {
+		s = s[:10] + "T" + s[11:]
+	}
+	return Parser{LeapSeconds: c.LeapSeconds}.ParseDateTime(s)
+}
+
+// ScanDateTime returns a sql.Scanner that scans a value into dst according
+// to the policies of c. It accepts the sources accepted by DateTime.Scan,
+// which may carry a UTC offset of the form "Z", "+02", "+0200" or "+02:00",
+// optionally preceded by a space.
+//
+// A time.Time source always carries a location; under RejectOffset and
+// StripOffset its wall clock is used, as by DateTime.Scan.
+func (c Codec) ScanDateTime(dst *DateTime) sql.Scanner {
+	return scannerFunc(func(src any) error {
+		switch v := src.(type) {
+		case time.Time:
+			if c.Offsets == ConvertOffset {
+				v = v.In(c.location())
+			}
+			*dst = DateTimeOf(v)
+			return nil
+		case []byte:
+			src = string(v)
+		}
+		s, ok := src.(string)
+		if !ok {
+			return dst.Scan(src)
+		}
+		rest, offset, hasOffset, err := splitOffset(s)
+		if err != nil {
+			return err
+		}
+		dt, err := c.ParseDateTime(rest)
+		if err != nil {
+			return err
+		}
+		if hasOffset {
+			if dt, err = c.applyOffset(s, dt, offset); err != nil {
+				return err
+			}
+		}
+		*dst = dt
+		return nil
+	})
+}
+
+// ScanTime returns a sql.Scanner that scans a value into dst according to
+// the policies of c. It accepts the sources accepted by Time.Scan, which may
+// carry a UTC offset as described in ScanDateTime, such as the
+// "03:42:31+02" with which PostgreSQL renders a time with time zone.
+//
+// Because a Time has no date, ConvertOffset converts it using the offset in
+// effect in the Codec's Location on c.ReferenceDate, or by default on the
+// current date, as PostgreSQL does.
+func (c Codec) ScanTime(dst *Time) sql.Scanner {
+	return scannerFunc(func(src any) error {
+		switch v := src.(type) {
+		case time.Time:
+			if c.Offsets == ConvertOffset {
+				v = v.In(c.location())
+			}
+			*dst = TimeOf(v)
+			return nil
+		case []byte:
+			src = string(v)
+		}
+		s, ok := src.(string)
+		if !ok {
+			return dst.Scan(src)
+		}
+		rest, offset, hasOffset, err := splitOffset(s)
+		if err != nil {
+			return err
+		}
+		t, err := Parser{LeapSeconds: c.LeapSeconds}.ParseTime(rest)
+		if err != nil {
+			return err
+		}
+		if hasOffset {
+			day := c.ReferenceDate
+			if day.IsZero() {
+				day = DateOf(time.Now().In(c.location()))
+			}
+			dt, err := c.applyOffset(s, DateTime{Date: day, Time: t}, offset)
+			if err != nil {
+				return err
+			}
+			t = dt.Time
+		}
+		*dst = t
+		return nil
+	})
+}
+
+// applyOffset applies the policy of c to dt, which was parsed from src with
+// a UTC offset of the given number of seconds.
+func (c Codec) applyOffset(src string, dt DateTime, offset int) (DateTime, error) {
+	switch c.Offsets {
+	case StripOffset:
+		return dt, nil
+	case ConvertOffset:
+		t := dt.In(time.FixedZone("", offset))
+		return DateTimeOf(t.In(c.location())), nil
+	}
+	return DateTime{}, fmt.Errorf("civil: unexpected UTC offset in %q", src)
+}
+
+func (c Codec) location() *time.Location {
+	if c.Location == nil {
+		return time.UTC
+	}
+	return c.Location
+}
+
+// scannerFunc adapts a function to the sql.Scanner interface.
+type scannerFunc func(src any) error
+
+func (f scannerFunc) Scan(src any) error { return f(src) }
+
+// splitOffset splits a trailing UTC offset, of the form "Z", "+hh",
+// "+hhmm" or "+hh:mm" and optionally preceded by a space, from a time or
+// datetime string. It returns the offset in seconds east of UTC.
+func splitOffset(s string) (rest string, offset int, ok bool, err error) {
+	if strings.HasSuffix(s, "Z") || strings.HasSuffix(s, "z") {
+		return strings.TrimSuffix(s[:len(s)-1], " "), 0, true, nil
+	}
+	// The offset follows the time, so its sign comes after the first colon;
+	// the hyphens of a date come before it. A string without a colon has no
+	// time, and so no offset.
+	i, colon := strings.LastIndexAny(s, "+-"), strings.IndexByte(s, ':')
+	if i < 0 || colon < 0 || i < colon {
+		return s, 0, false, nil
+	}
+	rest, sign, off := strings.TrimSuffix(s[:i], " "), s[i], strings.Replace(s[i+1:], ":", "", 1)
+	var hh, mm int
+	switch len(off) {
+	case 2:
+		hh, err = strconv.Atoi(off)
+	case 4:
+		if hh, err = strconv.Atoi(off[:2]); err == nil {
+			mm, err = strconv.Atoi(off[2:])
+		}
+	default:
+		err = strconv.ErrSyntax
+	}
+	if err != nil || !isDigits(off) || hh > 23 || mm > 59 {
+		return "", 0, false, fmt.Errorf("civil: malformed UTC offset in %q", s)
+	}
+	offset = hh*3600 + mm*60
+	if sign == '-' {
+		offset = -offset
+	}
+	return rest, offset, true, nil
+}
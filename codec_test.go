@@ -0,0 +1,196 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSplitOffset(t *testing.T) {
+	for _, test := range []struct {
+		s      string
+		rest   string
+		offset int
+		ok     bool
+	}{
+		{"2024-03-10T12:00:00", "2024-03-10T12:00:00", 0, false},
+		{"2024-03-10", "2024-03-10", 0, false},
+		{"2024-03-10T12:00:00Z", "2024-03-10T12:00:00", 0, true},
+		{"2024-03-10 12:00:00 z", "2024-03-10 12:00:00", 0, true},
+		{"2024-03-10 12:00:00+02", "2024-03-10 12:00:00", 7200, true},
+		{"2024-03-10T12:00:00-0530", "2024-03-10T12:00:00", -(5*3600 + 30*60), true},
+		{"12:00:00.5 +05:45", "12:00:00.5", 5*3600 + 45*60, true},
+	} {
+		rest, offset, ok, err := splitOffset(test.s)
+		if err != nil || rest != test.rest || offset != test.offset || ok != test.ok {
+			t.Errorf("splitOffset(%q) = %q, %d, %t, %v, want %q, %d, %t, nil",
+				test.s, rest, offset, ok, err, test.rest, test.offset, test.ok)
+		}
+	}
+	for _, s := range []string{
+		"12:00:00+2",
+		"12:00:00+020",
+		"12:00:00+24",
+		"12:00:00+02:60",
+		"12:00:00+0a",
+		"12:00:00+",
+	} {
+		if _, _, _, err := splitOffset(s); err == nil {
+			t.Errorf("splitOffset(%q) succeeded, want error", s)
+		}
+	}
+}
+
+func TestCodecScanDateTime(t *testing.T) {
+	tokyo := time.FixedZone("JST", 9*3600)
+	want := DateTime{Date{2024, 3, 10}, Time{12, 0, 0, 0}}
+	for _, test := range []struct {
+		c    Codec
+		src  any
+		want DateTime
+	}{
+		{Codec{}, "2024-03-10T12:00:00", want},
+		{Codec{}, []byte("2024-03-10 12:00:00"), want},
+		{Codec{Offsets: StripOffset}, "2024-03-10 12:00:00+02", want},
+		{Codec{Offsets: ConvertOffset}, "2024-03-10 12:00:00+02", DateTime{Date{2024, 3, 10}, Time{10, 0, 0, 0}}},
+		{Codec{Offsets: ConvertOffset}, "2024-03-10T12:00:00Z", want},
+		{Codec{Offsets: ConvertOffset, Location: tokyo}, "2024-03-10 20:00:00-05:00", DateTime{Date{2024, 3, 11}, Time{10, 0, 0, 0}}},
+		{Codec{}, time.Date(2024, 3, 10, 12, 0, 0, 0, tokyo), want},
+		{Codec{Offsets: ConvertOffset}, time.Date(2024, 3, 10, 12, 0, 0, 0, tokyo), DateTime{Date{2024, 3, 10}, Time{3, 0, 0, 0}}},
+	} {
+		var got DateTime
+		if err := test.c.ScanDateTime(&got).Scan(test.src); err != nil || got != test.want {
+			t.Errorf("%+v.ScanDateTime(%v) = %v, %v, want %v", test.c, test.src, got, err, test.want)
+		}
+	}
+	for _, test := range []struct {
+		c   Codec
+		src any
+	}{
+		{Codec{}, "2024-03-10 12:00:00+02"},
+		{Codec{}, "2024-03-10T12:00:00Z"},
+		{Codec{Offsets: StripOffset}, "2024-03-10 12:00:00+2"},
+		{Codec{Offsets: StripOffset}, "2024-03-10"},
+		{Codec{}, 42},
+	} {
+		var got DateTime
+		if err := test.c.ScanDateTime(&got).Scan(test.src); err == nil {
+			t.Errorf("%+v.ScanDateTime(%v) = %v, want error", test.c, test.src, got)
+		}
+	}
+}
+
+func TestOffsetPolicyString(t *testing.T) {
+	for p, want := range map[OffsetPolicy]string{
+		RejectOffset:    "RejectOffset",
+		StripOffset:     "StripOffset",
+		ConvertOffset:   "ConvertOffset",
+		OffsetPolicy(7): "OffsetPolicy(7)",
+	} {
+		if got := p.String(); got != want {
+			t.Errorf("OffsetPolicy(%d).String() = %q, want %q", int(p), got, want)
+		}
+	}
+}
+
+func TestCodecDateTimeSeparator(t *testing.T) {
+	dt := DateTime{Date{2024, 3, 10}, Time{12, 30, 0, 0}}
+	for _, test := range []struct {
+		sep  byte
+		want string
+	}{
+		{0, "2024-03-10T12:30:00"},
+		{'T', "2024-03-10T12:30:00"},
+		{' ', "2024-03-10 12:30:00"},
+	} {
+		c := Codec{DateTimeSeparator: test.sep}
+		s := c.FormatDateTime(dt)
+		if s != test.want {
+			t.Errorf("FormatDateTime with %q = %q, want %q", test.sep, s, test.want)
+		}
+		if got, err := c.ParseDateTime(s); err != nil || got != dt {
+			t.Errorf("ParseDateTime(%q) = %v, %v, want %v", s, got, err, dt)
+		}
+	}
+	// Either separator is accepted regardless of the configured one.
+	for _, s := range []string{"2024-03-10T12:30:00", "2024-03-10 12:30:00"} {
+		if got, err := (Codec{DateTimeSeparator: ' '}).ParseDateTime(s); err != nil || got != dt {
+			t.Errorf("ParseDateTime(%q) = %v, %v, want %v", s, got, err, dt)
+		}
+	}
+	for _, s := range []string{"2024-03-10  12:30:00", "2024-03-10_12:30:00", "2024-03-10"} {
+		if got, err := (Codec{}).ParseDateTime(s); err == nil {
+			t.Errorf("ParseDateTime(%q) = %v, want error", s, got)
+		}
+	}
+}
+
+func TestCodecScanTime(t *testing.T) {
+	for _, test := range []struct {
+		c    Codec
+		src  any
+		want Time
+	}{
+		{Codec{}, "03:42:31", Time{3, 42, 31, 0}},
+		{Codec{}, []byte("03:42:31.25"), Time{3, 42, 31, 250000000}},
+		{Codec{Offsets: StripOffset}, "03:42:31+02", Time{3, 42, 31, 0}},
+		{Codec{Offsets: ConvertOffset}, "03:42:31+02", Time{1, 42, 31, 0}},
+		{Codec{Offsets: ConvertOffset}, "23:00:00 -01:30", Time{0, 30, 0, 0}},
+		{Codec{Offsets: ConvertOffset, Location: time.FixedZone("", 3600)}, "12:00:00Z", Time{13, 0, 0, 0}},
+		{Codec{Offsets: ConvertOffset}, time.Date(2024, 1, 1, 12, 0, 0, 0, time.FixedZone("", -3600)), Time{13, 0, 0, 0}},
+		{Codec{}, time.Date(2024, 1, 1, 12, 0, 0, 0, time.FixedZone("", -3600)), Time{12, 0, 0, 0}},
+	} {
+		var got Time
+		if err := test.c.ScanTime(&got).Scan(test.src); err != nil || got != test.want {
+			t.Errorf("%+v.ScanTime(%v) = %v, %v, want %v", test.c, test.src, got, err, test.want)
+		}
+	}
+	for _, test := range []struct {
+		c   Codec
+		src any
+	}{
+		{Codec{}, "03:42:31+02"},
+		{Codec{}, "03:42:31Z"},
+		{Codec{Offsets: StripOffset}, "03:42:31+2"},
+		{Codec{Offsets: StripOffset}, "03:42"},
+		{Codec{}, 3.5},
+	} {
+		var got Time
+		if err := test.c.ScanTime(&got).Scan(test.src); err == nil {
+			t.Errorf("%+v.ScanTime(%v) = %v, want error", test.c, test.src, got)
+		}
+	}
+}
+
+func TestCodecScanTimeReferenceDate(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("no time zone database: %v", err)
+	}
+	for _, test := range []struct {
+		day  Date
+		want Time
+	}{
+		{Date{2024, 1, 15}, Time{Hour: 7}},
+		{Date{2024, 7, 15}, Time{Hour: 8}},
+	} {
+		c := Codec{Offsets: ConvertOffset, Location: ny, ReferenceDate: test.day}
+		var got Time
+		if err := c.ScanTime(&got).Scan("12:00:00Z"); err != nil || got != test.want {
+			t.Errorf("ScanTime(12:00:00Z) in New York on %v = %v, %v, want %v", test.day, got, err, test.want)
+		}
+	}
+}
@@ -0,0 +1,231 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"fmt"
+	"math/bits"
+	"strconv"
+	"strings"
+)
+
+// A Cron is a parsed cron expression. It is evaluated in civil time, so a
+// schedule such as "30 2 * * *" fires at 02:30 every day, whatever the time
+// zone's transitions; apply a time zone to the result to find the instant.
+type Cron struct {
+	minute uint64 // bit n is set if minute n matches
+	hour   uint32
+	dom    uint32
+	month  uint16
+	dow    uint8 // bit n is set if time.Weekday(n) matches
+
+	// If both the day of the month and the day of the week are restricted,
+	// a day matches if either does, as in Vixie cron. As there, a field
+	// starting with '*', such as "*/2", does not count as restricted, so
+	// "0 0 */2 * MON" matches the odd days of the month that are Mondays.
+	domAny, dowAny bool
+}
+
+var cronMacros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+var (
+	cronMonths   = []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}
+	cronWeekdays = []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}
+)
+
+// ParseCron parses a standard five-field cron expression: minute, hour, day
+// of the month, month and day of the week. Each field is '*' or a
+// comma-separated list of values, ranges such as "1-5" and steps such as
+// "*/15" or "0-30/10". Months and days of the week may be given by their
+// three-letter English names, and Sunday is either 0 or 7. The macros
+// "@yearly", "@monthly", "@weekly", "@daily" and "@hourly" are also
+// accepted.
+func ParseCron(expr string) (Cron, error) {
+	s := expr
+	if m, ok := cronMacros[strings.ToLower(s)]; ok {
+		s = m
+	}
+	f := strings.Fields(s)
+	if len(f) != 5 {
+		return Cron{}, fmt.Errorf("civil: cron expression %q does not have 5 fields", expr)
+	}
+	var c Cron
+	var mask [5]uint64
+	for i, r := range []struct {
+		lo, hi int
+		names  []string
+	}{{0, 59, nil}, {0, 23, nil}, {1, 31, nil}, {1, 12, cronMonths}, {0, 7, cronWeekdays}} {
+		var err error
+		if mask[i], err = parseCronField(f[i], r.lo, r.hi, r.names); err != nil {
+			return Cron{}, fmt.Errorf("civil: %v in cron expression %q", err, expr)
+		}
+	}
+	if mask[4]&(1<<7) != 0 {
+		mask[4] |= 1 // Sunday
+	}
+	c.minute, c.hour, c.dom = mask[0], uint32(mask[1]), uint32(mask[2])
+	c.month, c.dow = uint16(mask[3]), uint8(mask[4]&0x7f)
+	starred := func(field string) bool { return strings.HasPrefix(field, "*") || field == "?" }
+	c.domAny, c.dowAny = starred(f[2]), starred(f[4])
+	return c, nil
+}
+
+// parseCronField returns the set of values in [lo, hi] that a cron field
+// matches, as a bitmask. Names, if any, stand for values from lo.
+func parseCronField(field string, lo, hi int, names []string) (uint64, error) {
+	value := func(s string) (int, error) {
+		for i, n := range names {
+			if strings.EqualFold(s, n) {
+				return lo + i, nil
+			}
+		}
+		n, err := strconv.Atoi(s)
+		if err != nil || n < lo || n > hi || !isDigits(s) {
+			return 0, fmt.Errorf("invalid value %q", s)
+		}
+		return n, nil
+	}
+	var mask uint64
+	for _, term := range strings.Split(field, ",") {
+		rng, step, hasStep := strings.Cut(term, "/")
+		first, last := lo, hi
+		if rng != "*" && rng != "?" {
+			a, b, isRange := strings.Cut(rng, "-")
+			var err error
+			if first, err = value(a); err != nil {
+				return 0, err
+			}
+			last = first
+			if isRange {
+				if last, err = value(b); err != nil {
+					return 0, err
+				}
+			} else if hasStep {
+				last = hi
+			}
+			if last < first {
+				return 0, fmt.Errorf("invalid range %q", rng)
+			}
+		}
+		n := 1
+		if hasStep {
+			var err error
+			if n, err = strconv.Atoi(step); err != nil || n < 1 || !isDigits(step) {
+				return 0, fmt.Errorf("invalid step %q", step)
+			}
+		}
+		for v := first; v <= last; v += n {
+			mask |= 1 << v
+		}
+	}
+	return mask, nil
+}
+
+// Matches reports whether the schedule fires at dt. The seconds and
+// nanoseconds of dt are ignored.
+func (c Cron) Matches(dt DateTime) bool {
+	return c.matchesDate(dt.Date) && c.hour&(1<<dt.Time.Hour) != 0 && c.minute&(1<<dt.Time.Minute) != 0
+}
+
+// matchesDate reports whether the schedule fires on some minute of d.
+func (c Cron) matchesDate(d Date) bool {
+	if c.month&(1<<d.Month) == 0 {
+		return false
+	}
+	dom, dow := c.dom&(1<<d.Day) != 0, c.dow&(1<<d.Weekday()) != 0
+	if c.domAny || c.dowAny {
+		return dom && dow
+	}
+	return dom || dow
+}
+
+// Next returns the first time after dt at which the schedule fires. It
+// reports false if there is none by MaxDateTime, as for "0 0 30 2 *".
+func (c Cron) Next(dt DateTime) (DateTime, bool) {
+	// Start from the next whole minute.
+	t := Time{Hour: dt.Time.Hour, Minute: dt.Time.Minute}
+	d := dt.Date
+	if t.Minute++; t.Minute == 60 {
+		t.Hour, t.Minute = t.Hour+1, 0
+	}
+	if t.Hour == 24 {
+		d, t = d.AddDays(1), Time{}
+	}
+	for !d.After(MaxDate) {
+		switch {
+		case c.month&(1<<d.Month) == 0:
+			d = YearMonth{Year: d.Year, Month: d.Month}.FirstDate().AddMonths(1)
+			t = Time{}
+		case !c.matchesDate(d):
+			d, t = d.AddDays(1), Time{}
+		default:
+			if next, ok := c.nextInDay(t); ok {
+				return DateTime{Date: d, Time: next}, true
+			}
+			d, t = d.AddDays(1), Time{}
+		}
+	}
+	return DateTime{}, false
+}
+
+// nextInDay returns the first time of day at or after t at which the
+// schedule fires, on a day on which it fires.
+func (c Cron) nextInDay(t Time) (Time, bool) {
+	for h := t.Hour; h < 24; h++ {
+		if c.hour&(1<<h) == 0 {
+			continue
+		}
+		from := 0
+		if h == t.Hour {
+			from = t.Minute
+		}
+		if m := c.minute >> from; m != 0 {
+			return Time{Hour: h, Minute: from + bits.TrailingZeros64(m)}, true
+		}
+	}
+	return Time{}, false
+}
+
+// MatchesCron reports whether the cron expression expr fires at dt. See
+// ParseCron for the syntax of expr.
+func MatchesCron(expr string, dt DateTime) (bool, error) {
+	c, err := ParseCron(expr)
+	if err != nil {
+		return false, err
+	}
+	return c.Matches(dt), nil
+}
+
+// NextCronAfter returns the first time after dt at which the cron expression
+// expr fires. See ParseCron for the syntax of expr.
+func NextCronAfter(expr string, dt DateTime) (DateTime, error) {
+	c, err := ParseCron(expr)
+	if err != nil {
+		return DateTime{}, err
+	}
+	next, ok := c.Next(dt)
+	if !ok {
+		return DateTime{}, fmt.Errorf("civil: cron expression %q does not fire after %v", expr, dt)
+	}
+	return next, nil
+}
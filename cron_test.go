@@ -0,0 +1,155 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseCronEquivalent(t *testing.T) {
+	for _, test := range []struct {
+		a, b string
+	}{
+		{"@yearly", "0 0 1 1 *"},
+		{"@ANNUALLY", "0 0 1 1 *"},
+		{"@monthly", "0 0 1 * *"},
+		{"@weekly", "0 0 * * 0"},
+		{"@daily", "0 0 * * *"},
+		{"@midnight", "0 0 * * *"},
+		{"@hourly", "0 * * * *"},
+		{"0 9 * jan-mar mon-fri", "0 9 * 1-3 1-5"},
+		{"0 9 * * 7", "0 9 * * 0"},
+		{"0 9 * * SUN", "0 9 * * 0"},
+		{"*/15 * * * *", "0,15,30,45 * * * *"},
+		{"0-30/10 * * * *", "0,10,20,30 * * * *"},
+		{"5/20 * * * *", "5,25,45 * * * *"},
+		{"0 0 ? * MON", "0 0 * * 1"},
+	} {
+		a, err := ParseCron(test.a)
+		if err != nil {
+			t.Errorf("ParseCron(%q): %v", test.a, err)
+			continue
+		}
+		b, err := ParseCron(test.b)
+		if err != nil {
+			t.Errorf("ParseCron(%q): %v", test.b, err)
+			continue
+		}
+		if a != b {
+			t.Errorf("ParseCron(%q) = %+v, want ParseCron(%q) = %+v", test.a, a, test.b, b)
+		}
+	}
+}
+
+func TestParseCronRejects(t *testing.T) {
+	for _, expr := range []string{
+		"",
+		"* * * *",
+		"* * * * * *",
+		"@reboot",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * 32 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"5-1 * * * *",
+		"*/0 * * * *",
+		"*/x * * * *",
+		"+5 * * * *",
+		"1,,2 * * * *",
+		"* * * FOO *",
+	} {
+		if c, err := ParseCron(expr); err == nil {
+			t.Errorf("ParseCron(%q) = %+v, want error", expr, c)
+		}
+	}
+}
+
+func TestCronMatches(t *testing.T) {
+	dt := func(y, m, d, h, min int) DateTime {
+		return DateTime{Date{y, time.Month(m), d}, Time{Hour: h, Minute: min}}
+	}
+	for _, test := range []struct {
+		expr string
+		dt   DateTime
+		want bool
+	}{
+		{"30 2 * * *", dt(2024, 3, 10, 2, 30), true},
+		{"30 2 * * *", dt(2024, 3, 10, 2, 31), false},
+		{"0 9 * * MON-FRI", dt(2024, 6, 7, 9, 0), true},
+		{"0 9 * * MON-FRI", dt(2024, 6, 8, 9, 0), false},
+		// Either the day of the month or the day of the week.
+		{"0 0 13 * FRI", dt(2024, 6, 13, 0, 0), true},
+		{"0 0 13 * FRI", dt(2024, 6, 14, 0, 0), true},
+		{"0 0 13 * FRI", dt(2024, 6, 15, 0, 0), false},
+		{"0 0 29 2 *", dt(2024, 2, 29, 0, 0), true},
+		// A field starting with '*' is not restricted, so both must match.
+		{"0 0 */2 * MON", dt(2024, 6, 3, 0, 0), true},
+		{"0 0 */2 * MON", dt(2024, 6, 10, 0, 0), false},
+		{"0 0 */2 * MON", dt(2024, 6, 5, 0, 0), false},
+		{"0 0 1 * */2", dt(2024, 3, 1, 0, 0), false},
+		{"0 0 1 * */2", dt(2024, 9, 1, 0, 0), true},
+		{"0 0 1-31/2 * MON", dt(2024, 6, 5, 0, 0), true},
+	} {
+		got, err := MatchesCron(test.expr, test.dt)
+		if err != nil || got != test.want {
+			t.Errorf("MatchesCron(%q, %v) = %t, %v, want %t", test.expr, test.dt, got, err, test.want)
+		}
+	}
+	if _, err := MatchesCron("bad", dt(2024, 1, 1, 0, 0)); err == nil {
+		t.Error("MatchesCron(\"bad\") did not fail")
+	}
+}
+
+func TestCronNext(t *testing.T) {
+	dt := func(y, m, d, h, min int) DateTime {
+		return DateTime{Date{y, time.Month(m), d}, Time{Hour: h, Minute: min}}
+	}
+	for _, test := range []struct {
+		expr string
+		from DateTime
+		want DateTime
+	}{
+		{"*/15 * * * *", dt(2024, 1, 1, 10, 7), dt(2024, 1, 1, 10, 15)},
+		{"*/15 * * * *", dt(2024, 1, 1, 10, 15), dt(2024, 1, 1, 10, 30)},
+		{"0 0 * * *", dt(2024, 12, 31, 23, 59), dt(2025, 1, 1, 0, 0)},
+		{"30 2 * * *", dt(2024, 3, 10, 2, 30), dt(2024, 3, 11, 2, 30)},
+		{"0 9 * * MON", dt(2024, 6, 5, 12, 0), dt(2024, 6, 10, 9, 0)},
+		{"0 0 29 2 *", dt(2024, 3, 1, 0, 0), dt(2028, 2, 29, 0, 0)},
+		{"@yearly", dt(2024, 6, 1, 0, 0), dt(2025, 1, 1, 0, 0)},
+		{"0 12 L * *", dt(2024, 1, 1, 0, 0), DateTime{}},
+	} {
+		got, err := NextCronAfter(test.expr, test.from)
+		if test.want.IsZero() {
+			if err == nil {
+				t.Errorf("NextCronAfter(%q, %v) = %v, want error", test.expr, test.from, got)
+			}
+			continue
+		}
+		if err != nil || got != test.want {
+			t.Errorf("NextCronAfter(%q, %v) = %v, %v, want %v", test.expr, test.from, got, err, test.want)
+		}
+	}
+	// Seconds are dropped before looking for the next minute.
+	from := DateTime{Date{2024, 1, 1}, Time{Hour: 10, Minute: 14, Second: 59}}
+	if got, _ := NextCronAfter("*/15 * * * *", from); got != dt(2024, 1, 1, 10, 15) {
+		t.Errorf("NextCronAfter(\"*/15 * * * *\", %v) = %v, want 2024-01-01T10:15:00", from, got)
+	}
+	if got, err := NextCronAfter("0 0 30 2 *", dt(2024, 1, 1, 0, 0)); err == nil {
+		t.Errorf("NextCronAfter(\"0 0 30 2 *\") = %v, want error", got)
+	}
+}
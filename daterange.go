@@ -0,0 +1,161 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// A DateRange represents the dates from Start to End, inclusive.
+type DateRange struct {
+	Start Date // The first date in the range.
+	End   Date // The last date in the range.
+}
+
+// ParseDateRange parses an ISO 8601 interval of dates and returns the range
+// it represents. The interval has one of the forms START/END, START/PERIOD
+// and PERIOD/END, where START and END are in a format accepted by ParseDate
+// and PERIOD is in a format accepted by ParsePeriod. END is the last date of
+// the range, so "2024-01-01/2024-01-31", "2024-01-01/P1M" and
+// "P1M/2024-01-31" all represent January 2024.
+func ParseDateRange(s string) (DateRange, error) {
+	start, end, ok := strings.Cut(s, "/")
+	if !ok {
+		return DateRange{}, fmt.Errorf("civil: cannot parse %q as a date range", s)
+	}
+	var r DateRange
+	var err error
+	switch {
+	case strings.HasPrefix(start, "P"):
+		var p Period
+		if p, err = ParsePeriod(start); err != nil {
+			return DateRange{}, err
+		}
+		if r.End, err = ParseDate(end); err != nil {
+			return DateRange{}, err
+		}
+		r.Start = r.End.AddDays(1).AddPeriod(p.neg())
+	case strings.HasPrefix(end, "P"):
+		if r.Start, err = ParseDate(start); err != nil {
+			return DateRange{}, err
+		}
+		var p Period
+		if p, err = ParsePeriod(end); err != nil {
+			return DateRange{}, err
+		}
+		r.End = r.Start.AddPeriod(p).AddDays(-1)
+	default:
+		if r.Start, err = ParseDate(start); err != nil {
+			return DateRange{}, err
+		}
+		if r.End, err = ParseDate(end); err != nil {
+			return DateRange{}, err
+		}
+	}
+	return r, nil
+}
+
+// String returns the range in the format START/END, using the format
+// described in Date.String.
+func (r DateRange) String() string {
+	return r.Start.String() + "/" + r.End.String()
+}
+
+// IsValid reports whether both dates are valid and Start is not after End.
+func (r DateRange) IsValid() bool {
+	return r.Start.IsValid() && r.End.IsValid() && !r.Start.After(r.End)
+}
+
+// Days returns the number of dates in the range, including both Start and
+// End.
+func (r DateRange) Days() int {
+	return r.End.DaysSince(r.Start) + 1
+}
+
+// Contains reports whether d lies within the range.
+func (r DateRange) Contains(d Date) bool {
+	return d.IsBetween(r.Start, r.End, Closed)
+}
+
+// Overlaps reports whether r and o have at least one date in common.
+func (r DateRange) Overlaps(o DateRange) bool {
+	return !r.Start.After(o.End) && !o.Start.After(r.End)
+}
+
+// Intersect returns the dates common to r and o. The boolean result is false
+// if the ranges do not overlap, in which case the returned range is the zero
+// DateRange.
+func (r DateRange) Intersect(o DateRange) (DateRange, bool) {
+	if !r.Overlaps(o) {
+		return DateRange{}, false
+	}
+	if o.Start.After(r.Start) {
+		r.Start = o.Start
+	}
+	if o.End.Before(r.End) {
+		r.End = o.End
+	}
+	return r, true
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// The output is the result of r.String().
+func (r DateRange) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// The range is expected to be a string in a format accepted by
+// ParseDateRange.
+func (r *DateRange) UnmarshalText(data []byte) error {
+	var err error
+	*r, err = ParseDateRange(string(data))
+	return err
+}
+
+// DateRangeObject is a DateRange that is represented in JSON as an object,
+// such as {"start":"2024-01-01","end":"2024-01-31"}, rather than the
+// interval string used by DateRange.
+type DateRangeObject DateRange
+
+// dateRangeObject is the JSON representation of DateRangeObject.
+type dateRangeObject struct {
+	Start Date `json:"start"`
+	End   Date `json:"end"`
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (r DateRangeObject) MarshalJSON() ([]byte, error) {
+	return json.Marshal(dateRangeObject(r))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It accepts both the object form and the interval string form.
+func (r *DateRangeObject) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		dr, err := ParseDateRange(s)
+		*r = DateRangeObject(dr)
+		return err
+	}
+	var o dateRangeObject
+	if err := json.Unmarshal(data, &o); err != nil {
+		return err
+	}
+	*r = DateRangeObject(o)
+	return nil
+}
@@ -0,0 +1,215 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestDateRange(t *testing.T) {
+	r := func(y1 int, m1 time.Month, d1 int, y2 int, m2 time.Month, d2 int) DateRange {
+		return DateRange{Date{y1, m1, d1}, Date{y2, m2, d2}}
+	}
+	for _, test := range []struct {
+		r     DateRange
+		str   string
+		valid bool
+		days  int
+	}{
+		{r(2024, 1, 1, 2024, 1, 31), "2024-01-01/2024-01-31", true, 31},
+		{r(2024, 2, 29, 2024, 2, 29), "2024-02-29/2024-02-29", true, 1},
+		{r(2023, 12, 31, 2024, 1, 1), "2023-12-31/2024-01-01", true, 2},
+		{r(2024, 1, 2, 2024, 1, 1), "2024-01-02/2024-01-01", false, 0},
+		{r(2023, 2, 29, 2023, 3, 1), "2023-02-29/2023-03-01", false, 1},
+	} {
+		if got := test.r.String(); got != test.str {
+			t.Errorf("%#v.String() = %q, want %q", test.r, got, test.str)
+		}
+		if got := test.r.IsValid(); got != test.valid {
+			t.Errorf("%v.IsValid() = %t, want %t", test.r, got, test.valid)
+		}
+		if test.valid {
+			if got := test.r.Days(); got != test.days {
+				t.Errorf("%v.Days() = %d, want %d", test.r, got, test.days)
+			}
+		}
+	}
+
+	jan := r(2024, 1, 1, 2024, 1, 31)
+	for _, test := range []struct {
+		d    Date
+		want bool
+	}{
+		{Date{2023, 12, 31}, false},
+		{Date{2024, 1, 1}, true},
+		{Date{2024, 1, 15}, true},
+		{Date{2024, 1, 31}, true},
+		{Date{2024, 2, 1}, false},
+	} {
+		if got := jan.Contains(test.d); got != test.want {
+			t.Errorf("%v.Contains(%v) = %t, want %t", jan, test.d, got, test.want)
+		}
+	}
+}
+
+func TestParseDateRange(t *testing.T) {
+	for _, s := range []string{"2024-01-01/2024-01-31", "2024-02-29/2024-02-29", "2023-12-31/2024-01-01"} {
+		r, err := ParseDateRange(s)
+		if err != nil {
+			t.Errorf("ParseDateRange(%q): %v", s, err)
+			continue
+		}
+		if got := r.String(); got != s {
+			t.Errorf("ParseDateRange(%q).String() = %q", s, got)
+		}
+	}
+	for _, s := range []string{"", "2024-01-01", "2024-01-01/", "/2024-01-31", "2024-01-01/2024-02-30", "2024-01-01--2024-01-31", "2024-01-01/2024-01-31/2024-02-01"} {
+		if r, err := ParseDateRange(s); err == nil {
+			t.Errorf("ParseDateRange(%q) = %v, want error", s, r)
+		}
+	}
+}
+
+func TestDateRangeJSON(t *testing.T) {
+	jan := DateRange{Date{2024, 1, 1}, Date{2024, 1, 31}}
+	const (
+		interval = `"2024-01-01/2024-01-31"`
+		object   = `{"start":"2024-01-01","end":"2024-01-31"}`
+	)
+	for _, test := range []struct {
+		c    Codec
+		want string
+	}{
+		{Codec{}, interval},
+		{Codec{RangeForm: IntervalForm}, interval},
+		{Codec{RangeForm: ObjectForm}, object},
+	} {
+		data, err := test.c.MarshalDateRange(jan)
+		if err != nil || string(data) != test.want {
+			t.Errorf("MarshalDateRange with %v = %s, %v, want %s", test.c.RangeForm, data, err, test.want)
+		}
+	}
+	if data, err := json.Marshal(jan); err != nil || string(data) != interval {
+		t.Errorf("json.Marshal(%v) = %s, %v, want %s", jan, data, err, interval)
+	}
+	if data, err := json.Marshal(DateRangeObject(jan)); err != nil || string(data) != object {
+		t.Errorf("json.Marshal(DateRangeObject(%v)) = %s, %v, want %s", jan, data, err, object)
+	}
+
+	// The Codec accepts only its own form; DateRangeObject accepts either.
+	for _, test := range []struct {
+		c    Codec
+		data string
+		ok   bool
+	}{
+		{Codec{}, interval, true},
+		{Codec{}, object, false},
+		{Codec{RangeForm: ObjectForm}, object, true},
+		{Codec{RangeForm: ObjectForm}, `{"end":"2024-01-31","start":"2024-01-01"}`, true},
+		{Codec{RangeForm: ObjectForm}, interval, false},
+	} {
+		var r DateRange
+		err := test.c.UnmarshalDateRange([]byte(test.data), &r)
+		if test.ok && (err != nil || r != jan) {
+			t.Errorf("UnmarshalDateRange(%s) with %v = %v, %v, want %v", test.data, test.c.RangeForm, r, err, jan)
+		}
+		if !test.ok && err == nil {
+			t.Errorf("UnmarshalDateRange(%s) with %v = %v, want error", test.data, test.c.RangeForm, r)
+		}
+	}
+	for _, data := range []string{interval, object, `{"end":"2024-01-31","start":"2024-01-01"}`} {
+		var o DateRangeObject
+		if err := json.Unmarshal([]byte(data), &o); err != nil || DateRange(o) != jan {
+			t.Errorf("json.Unmarshal(%s) into DateRangeObject = %v, %v, want %v", data, o, err, jan)
+		}
+	}
+	var r DateRange
+	if err := json.Unmarshal([]byte(interval), &r); err != nil || r != jan {
+		t.Errorf("json.Unmarshal(%s) = %v, %v, want %v", interval, r, err, jan)
+	}
+	for _, data := range []string{`"2024-01-01"`, `{"start":"2024-01-01","end":"2024-02-30"}`, `42`, `["2024-01-01","2024-01-31"]`} {
+		for _, c := range []Codec{{}, {RangeForm: ObjectForm}} {
+			var r DateRange
+			if err := c.UnmarshalDateRange([]byte(data), &r); err == nil {
+				t.Errorf("UnmarshalDateRange(%s) with %v = %v, want error", data, c.RangeForm, r)
+			}
+		}
+	}
+}
+
+func TestDateRangeOverlaps(t *testing.T) {
+	r := func(m1 time.Month, d1 int, m2 time.Month, d2 int) DateRange {
+		return DateRange{Date{2024, m1, d1}, Date{2024, m2, d2}}
+	}
+	jan := r(1, 1, 1, 31)
+	for _, test := range []struct {
+		o       DateRange
+		want    DateRange
+		overlap bool
+	}{
+		{r(1, 1, 1, 31), jan, true},
+		{r(1, 10, 1, 20), r(1, 10, 1, 20), true},
+		{r(12, 1, 12, 31), DateRange{}, false},
+		{r(2, 1, 2, 29), DateRange{}, false},
+		{DateRange{Date{2023, 12, 1}, Date{2024, 1, 1}}, r(1, 1, 1, 1), true},
+		{r(1, 31, 2, 15), r(1, 31, 1, 31), true},
+		{DateRange{Date{2023, 12, 1}, Date{2024, 3, 1}}, jan, true},
+	} {
+		if got := jan.Overlaps(test.o); got != test.overlap {
+			t.Errorf("%v.Overlaps(%v) = %t, want %t", jan, test.o, got, test.overlap)
+		}
+		if got := test.o.Overlaps(jan); got != test.overlap {
+			t.Errorf("%v.Overlaps(%v) = %t, want %t", test.o, jan, got, test.overlap)
+		}
+		for _, p := range [][2]DateRange{{jan, test.o}, {test.o, jan}} {
+			got, ok := p[0].Intersect(p[1])
+			if got != test.want || ok != test.overlap {
+				t.Errorf("%v.Intersect(%v) = %v, %t, want %v, %t", p[0], p[1], got, ok, test.want, test.overlap)
+			}
+		}
+	}
+}
+
+func TestParseDateRangePeriod(t *testing.T) {
+	r := func(y1 int, m1 time.Month, d1 int, y2 int, m2 time.Month, d2 int) DateRange {
+		return DateRange{Date{y1, m1, d1}, Date{y2, m2, d2}}
+	}
+	for _, test := range []struct {
+		s    string
+		want DateRange
+	}{
+		{"2024-01-01/P1M", r(2024, 1, 1, 2024, 1, 31)},
+		{"P1M/2024-01-31", r(2024, 1, 1, 2024, 1, 31)},
+		{"2024-01-01/P1D", r(2024, 1, 1, 2024, 1, 1)},
+		{"2024-01-01/P1W", r(2024, 1, 1, 2024, 1, 7)},
+		{"2024-02-01/P1Y", r(2024, 2, 1, 2025, 1, 31)},
+		{"P1Y/2024-12-31", r(2024, 1, 1, 2024, 12, 31)},
+		// The period is added as by AddPeriod, so January 31 plus one month
+		// is March 2, and the range ends the day before.
+		{"2024-01-31/P1M", r(2024, 1, 31, 2024, 3, 1)},
+	} {
+		got, err := ParseDateRange(test.s)
+		if err != nil || got != test.want {
+			t.Errorf("ParseDateRange(%q) = %v, %v, want %v", test.s, got, err, test.want)
+		}
+	}
+	for _, s := range []string{"P1M/P1M", "2024-01-01/P1DT1H", "2024-01-01/P", "P1X/2024-01-31", "P1M/2024-02-30", "2024-01-01/1M"} {
+		if got, err := ParseDateRange(s); err == nil {
+			t.Errorf("ParseDateRange(%q) = %v, want error", s, got)
+		}
+	}
+}
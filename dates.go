@@ -0,0 +1,81 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import "slices"
+
+// The functions in this file treat a []Date as a set. Those returning a set
+// return it sorted in ascending order without duplicates, the form
+// ContainsDate expects.
+
+// SortDates sorts ds in ascending order.
+func SortDates(ds []Date) {
+	slices.SortFunc(ds, Date.Compare)
+}
+
+// DedupDates sorts ds and removes duplicate dates, returning the modified
+// slice.
+func DedupDates(ds []Date) []Date {
+	SortDates(ds)
+	return slices.Compact(ds)
+}
+
+// ContainsDate reports whether d is in ds, which must be sorted in ascending
+// order.
+func ContainsDate(ds []Date, d Date) bool {
+	_, found := slices.BinarySearchFunc(ds, d, Date.Compare)
+	return found
+}
+
+// UnionDates returns the dates that are in a, b, or both.
+// The arguments are not modified.
+func UnionDates(a, b []Date) []Date {
+	r := make([]Date, 0, len(a)+len(b))
+	r = append(r, a...)
+	r = append(r, b...)
+	return DedupDates(r)
+}
+
+// IntersectDates returns the dates that are in both a and b.
+// The arguments are not modified.
+func IntersectDates(a, b []Date) []Date {
+	a, b = DedupDates(slices.Clone(a)), DedupDates(slices.Clone(b))
+	var r []Date
+	for len(a) > 0 && len(b) > 0 {
+		switch c := a[0].Compare(b[0]); {
+		case c < 0:
+			a = a[1:]
+		case c > 0:
+			b = b[1:]
+		default:
+			r = append(r, a[0])
+			a, b = a[1:], b[1:]
+		}
+	}
+	return r
+}
+
+// DiffDates returns the dates that are in a but not in b.
+// The arguments are not modified.
+func DiffDates(a, b []Date) []Date {
+	a, b = DedupDates(slices.Clone(a)), DedupDates(slices.Clone(b))
+	var r []Date
+	for _, d := range a {
+		if !ContainsDate(b, d) {
+			r = append(r, d)
+		}
+	}
+	return r
+}
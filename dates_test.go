@@ -0,0 +1,64 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestDateSets(t *testing.T) {
+	d := func(day int) Date { return Date{2024, 1, day} }
+	a := []Date{d(5), d(1), d(3), d(1)}
+	b := []Date{d(3), d(4), d(5), d(5)}
+	aCopy, bCopy := slices.Clone(a), slices.Clone(b)
+	for _, test := range []struct {
+		name string
+		got  []Date
+		want []Date
+	}{
+		{"UnionDates", UnionDates(a, b), []Date{d(1), d(3), d(4), d(5)}},
+		{"IntersectDates", IntersectDates(a, b), []Date{d(3), d(5)}},
+		{"DiffDates", DiffDates(a, b), []Date{d(1)}},
+		{"DiffDates reversed", DiffDates(b, a), []Date{d(4)}},
+		{"UnionDates empty", UnionDates(nil, nil), []Date{}},
+		{"IntersectDates empty", IntersectDates(a, nil), nil},
+	} {
+		if !slices.Equal(test.got, test.want) {
+			t.Errorf("%s = %v, want %v", test.name, test.got, test.want)
+		}
+	}
+	if !slices.Equal(a, aCopy) || !slices.Equal(b, bCopy) {
+		t.Errorf("arguments modified: %v, %v", a, b)
+	}
+
+	ds := DedupDates([]Date{d(9), d(2), d(9), d(31), {2023, 12, 31}})
+	if want := []Date{{2023, 12, 31}, d(2), d(9), d(31)}; !slices.Equal(ds, want) {
+		t.Errorf("DedupDates = %v, want %v", ds, want)
+	}
+	for _, test := range []struct {
+		d    Date
+		want bool
+	}{
+		{d(2), true},
+		{Date{2023, 12, 31}, true},
+		{d(3), false},
+		{Date{2025, 1, 1}, false},
+	} {
+		if got := ContainsDate(ds, test.d); got != test.want {
+			t.Errorf("ContainsDate(%v, %v) = %t, want %t", ds, test.d, got, test.want)
+		}
+	}
+}
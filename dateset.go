@@ -0,0 +1,135 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"iter"
+	"math/bits"
+)
+
+// A DateSet is a set of dates stored as a bitmap over epoch days. It uses
+// one bit for each day between its earliest and latest dates, so a set of
+// every date in a century needs less than 5KB. The zero DateSet is an empty
+// set ready to use.
+type DateSet struct {
+	base  int      // the index of words[0], in units of 64 days
+	words []uint64 // bit i of words[j] is epoch day 64*(base+j) + i
+}
+
+// DateSetOf returns a set containing the given dates.
+func DateSetOf(ds ...Date) *DateSet {
+	s := &DateSet{}
+	for _, d := range ds {
+		s.Add(d)
+	}
+	return s
+}
+
+// Add adds d to the set.
+func (s *DateSet) Add(d Date) {
+	n := d.EpochDay()
+	w := n >> 6
+	switch {
+	case len(s.words) == 0:
+		s.base, s.words = w, make([]uint64, 1)
+	case w < s.base:
+		s.words = append(make([]uint64, s.base-w), s.words...)
+		s.base = w
+	case w >= s.base+len(s.words):
+		s.words = append(s.words, make([]uint64, w-s.base-len(s.words)+1)...)
+	}
+	s.words[w-s.base] |= 1 << (n & 63)
+}
+
+// AddRange adds the dates of r to the set.
+func (s *DateSet) AddRange(r DateRange) {
+	for d := r.Start; !d.After(r.End); d = d.AddDays(1) {
+		s.Add(d)
+	}
+}
+
+// Remove removes d from the set, if it is present.
+func (s *DateSet) Remove(d Date) {
+	n := d.EpochDay()
+	if i := n>>6 - s.base; 0 <= i && i < len(s.words) {
+		s.words[i] &^= 1 << (n & 63)
+	}
+}
+
+// Contains reports whether d is in the set.
+func (s *DateSet) Contains(d Date) bool {
+	n := d.EpochDay()
+	i := n>>6 - s.base
+	return 0 <= i && i < len(s.words) && s.words[i]&(1<<(n&63)) != 0
+}
+
+// Len returns the number of dates in the set.
+func (s *DateSet) Len() int {
+	n := 0
+	for _, w := range s.words {
+		n += bits.OnesCount64(w)
+	}
+	return n
+}
+
+// Clone returns a copy of the set.
+func (s *DateSet) Clone() *DateSet {
+	return &DateSet{base: s.base, words: append([]uint64(nil), s.words...)}
+}
+
+// Union returns a new set of the dates in s, o, or both.
+func (s *DateSet) Union(o *DateSet) *DateSet {
+	if len(o.words) == 0 {
+		return s.Clone()
+	}
+	if len(s.words) == 0 {
+		return o.Clone()
+	}
+	lo, hi := min(s.base, o.base), max(s.base+len(s.words), o.base+len(o.words))
+	r := &DateSet{base: lo, words: make([]uint64, hi-lo)}
+	copy(r.words[s.base-lo:], s.words)
+	for i, w := range o.words {
+		r.words[o.base-lo+i] |= w
+	}
+	return r
+}
+
+// Intersect returns a new set of the dates in both s and o.
+func (s *DateSet) Intersect(o *DateSet) *DateSet {
+	lo, hi := max(s.base, o.base), min(s.base+len(s.words), o.base+len(o.words))
+	if lo >= hi {
+		return &DateSet{}
+	}
+	r := &DateSet{base: lo, words: make([]uint64, hi-lo)}
+	for i := range r.words {
+		r.words[i] = s.words[lo-s.base+i] & o.words[lo-o.base+i]
+	}
+	return r
+}
+
+// All returns an iterator over the dates in the set, in ascending order.
+func (s *DateSet) All() iter.Seq[Date] {
+	return func(yield func(Date) bool) {
+		for i, w := range s.words {
+			for w != 0 {
+				b := bits.TrailingZeros64(w)
+				w &^= 1 << b
+				if !yield(DateFromEpochDay(64*(s.base+i) + b)) {
+					return
+				}
+			}
+		}
+	}
+}
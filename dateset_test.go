@@ -0,0 +1,111 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestDateSet(t *testing.T) {
+	var s DateSet
+	if s.Len() != 0 || s.Contains(Date{1970, 1, 1}) {
+		t.Fatal("zero DateSet is not empty")
+	}
+	// The dates straddle the epoch and word boundaries, and are added out
+	// of order so that the bitmap grows in both directions.
+	ds := []Date{{1970, 1, 1}, {1969, 12, 31}, {1970, 3, 6}, {1969, 10, 29}, {2024, 2, 29}}
+	for _, d := range ds {
+		s.Add(d)
+	}
+	s.Add(Date{1970, 1, 1})
+	if got := s.Len(); got != len(ds) {
+		t.Errorf("Len() = %d, want %d", got, len(ds))
+	}
+	for _, d := range ds {
+		if !s.Contains(d) {
+			t.Errorf("Contains(%v) = false", d)
+		}
+	}
+	for _, d := range []Date{{1970, 1, 2}, {1969, 12, 30}, {1900, 1, 1}, {2100, 1, 1}} {
+		if s.Contains(d) {
+			t.Errorf("Contains(%v) = true", d)
+		}
+	}
+	want := slices.Clone(ds)
+	slices.SortFunc(want, Date.Compare)
+	if got := slices.Collect(s.All()); !slices.Equal(got, want) {
+		t.Errorf("All() = %v, want %v", got, want)
+	}
+
+	c := s.Clone()
+	s.Remove(Date{1970, 1, 1})
+	s.Remove(Date{1800, 1, 1})
+	if s.Contains(Date{1970, 1, 1}) || s.Len() != len(ds)-1 {
+		t.Errorf("after Remove, Contains = %t, Len = %d", s.Contains(Date{1970, 1, 1}), s.Len())
+	}
+	if !c.Contains(Date{1970, 1, 1}) {
+		t.Error("Remove modified a clone")
+	}
+
+	r := &DateSet{}
+	r.AddRange(DateRange{Date{2024, 2, 27}, Date{2024, 3, 2}})
+	if got := r.Len(); got != 5 {
+		t.Errorf("AddRange of 5 days: Len() = %d", got)
+	}
+	r.AddRange(DateRange{Date{2024, 3, 2}, Date{2024, 3, 1}})
+	if got := r.Len(); got != 5 {
+		t.Errorf("AddRange of an invalid range: Len() = %d, want 5", got)
+	}
+}
+
+// TestDateSetRandom checks the set operations against maps.
+func TestDateSetRandom(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	random := func() (*DateSet, map[Date]bool) {
+		s, m := &DateSet{}, map[Date]bool{}
+		start := rng.Intn(2000) - 1000
+		for i := rng.Intn(50); i > 0; i-- {
+			d := DateFromEpochDay(start + rng.Intn(400))
+			s.Add(d)
+			m[d] = true
+		}
+		return s, m
+	}
+	check := func(name string, s *DateSet, want func(Date) bool) {
+		t.Helper()
+		count := 0
+		for i := -1500; i < 1500; i++ {
+			d := DateFromEpochDay(i)
+			if s.Contains(d) != want(d) {
+				t.Fatalf("%s: Contains(%v) = %t", name, d, s.Contains(d))
+			}
+			if want(d) {
+				count++
+			}
+		}
+		if s.Len() != count {
+			t.Fatalf("%s: Len() = %d, want %d", name, s.Len(), count)
+		}
+	}
+	for i := 0; i < 200; i++ {
+		a, am := random()
+		b, bm := random()
+		check("a", a, func(d Date) bool { return am[d] })
+		check("Union", a.Union(b), func(d Date) bool { return am[d] || bm[d] })
+		check("Intersect", a.Intersect(b), func(d Date) bool { return am[d] && bm[d] })
+	}
+}
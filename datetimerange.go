@@ -0,0 +1,168 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// A DateTimeRange represents the datetimes from Start up to, but not
+// including, End, such as the span of an appointment or a booking.
+type DateTimeRange struct {
+	Start DateTime // The first datetime in the range.
+	End   DateTime // The datetime immediately after the range.
+}
+
+// ParseDateTimeRange parses an ISO 8601 interval of datetimes and returns
+// the range it represents. The interval has one of the forms START/END,
+// START/DURATION and DURATION/END, where START and END are in a format
+// accepted by ParseDateTime and DURATION is in a format accepted by
+// ParseSpan, as in "2024-01-01T09:00:00/PT1H30M".
+func ParseDateTimeRange(s string) (DateTimeRange, error) {
+	start, end, ok := strings.Cut(s, "/")
+	if !ok {
+		return DateTimeRange{}, fmt.Errorf("civil: cannot parse %q as a datetime range", s)
+	}
+	var r DateTimeRange
+	var err error
+	switch {
+	case strings.HasPrefix(start, "P"):
+		var sp Span
+		if sp, err = ParseSpan(start); err != nil {
+			return DateTimeRange{}, err
+		}
+		if r.End, err = ParseDateTime(end); err != nil {
+			return DateTimeRange{}, err
+		}
+		r.Start = Span{Period: sp.Period.neg(), Duration: -sp.Duration}.AddTo(r.End)
+	case strings.HasPrefix(end, "P"):
+		if r.Start, err = ParseDateTime(start); err != nil {
+			return DateTimeRange{}, err
+		}
+		var sp Span
+		if sp, err = ParseSpan(end); err != nil {
+			return DateTimeRange{}, err
+		}
+		r.End = sp.AddTo(r.Start)
+	default:
+		if r.Start, err = ParseDateTime(start); err != nil {
+			return DateTimeRange{}, err
+		}
+		if r.End, err = ParseDateTime(end); err != nil {
+			return DateTimeRange{}, err
+		}
+	}
+	return r, nil
+}
+
+// String returns the range in the format START/END, using the format
+// described in DateTime.String.
+func (r DateTimeRange) String() string {
+	return r.Start.String() + "/" + r.End.String()
+}
+
+// IsValid reports whether both datetimes are valid and Start is not after
+// End.
+func (r DateTimeRange) IsValid() bool {
+	return r.Start.IsValid() && r.End.IsValid() && !r.Start.After(r.End)
+}
+
+// IsEmpty reports whether the range contains no datetimes.
+func (r DateTimeRange) IsEmpty() bool {
+	return !r.Start.Before(r.End)
+}
+
+// Duration returns the length of the range, assuming every day has 24 hours.
+// As with time.Time.Sub, the result saturates at the minimum or maximum
+// time.Duration.
+func (r DateTimeRange) Duration() time.Duration {
+	return r.End.Sub(r.Start)
+}
+
+// Contains reports whether dt lies within the range.
+func (r DateTimeRange) Contains(dt DateTime) bool {
+	return dt.IsBetween(r.Start, r.End, ClosedOpen)
+}
+
+// Overlaps reports whether r and o have at least one datetime in common. An
+// empty range overlaps no range, not even one that contains its Start.
+func (r DateTimeRange) Overlaps(o DateTimeRange) bool {
+	if r.IsEmpty() || o.IsEmpty() {
+		return false
+	}
+	return r.Start.Before(o.End) && o.Start.Before(r.End)
+}
+
+// Intersect returns the datetimes common to r and o. The boolean result is
+// false if the ranges do not overlap, in which case the returned range is
+// the zero DateTimeRange.
+func (r DateTimeRange) Intersect(o DateTimeRange) (DateTimeRange, bool) {
+	if !r.Overlaps(o) {
+		return DateTimeRange{}, false
+	}
+	if o.Start.After(r.Start) {
+		r.Start = o.Start
+	}
+	if o.End.Before(r.End) {
+		r.End = o.End
+	}
+	return r, true
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// The output is the result of r.String().
+func (r DateTimeRange) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// The range is expected to be a string in a format accepted by
+// ParseDateTimeRange.
+func (r *DateTimeRange) UnmarshalText(data []byte) error {
+	var err error
+	*r, err = ParseDateTimeRange(string(data))
+	return err
+}
+
+// DateTimeRangeObject is a DateTimeRange that is represented in JSON as an
+// object, such as {"start":"2024-01-01T09:00:00","end":"2024-01-01T17:30:00"},
+// rather than the interval string used by DateTimeRange. It is encoded and
+// decoded as by the zero Codec with RangeForm set to ObjectForm, except that
+// the interval string form is also accepted on input.
+type DateTimeRangeObject DateTimeRange
+
+// dateTimeRangeObject is the JSON representation of DateTimeRangeObject.
+type dateTimeRangeObject struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (r DateTimeRangeObject) MarshalJSON() ([]byte, error) {
+	return Codec{RangeForm: ObjectForm}.MarshalDateTimeRange(DateTimeRange(r))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It accepts both the object form and the interval string form.
+func (r *DateTimeRangeObject) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		return Codec{}.UnmarshalDateTimeRange(data, (*DateTimeRange)(r))
+	}
+	return Codec{RangeForm: ObjectForm}.UnmarshalDateTimeRange(data, (*DateTimeRange)(r))
+}
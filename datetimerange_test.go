@@ -0,0 +1,163 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestParseDateTimeRange(t *testing.T) {
+	dt := func(y int, m time.Month, d, h, min int) DateTime {
+		return DateTime{Date{y, m, d}, Time{Hour: h, Minute: min}}
+	}
+	for _, test := range []struct {
+		in   string
+		want DateTimeRange
+	}{
+		{"2024-01-01T09:00:00/2024-01-01T10:30:00", DateTimeRange{dt(2024, 1, 1, 9, 0), dt(2024, 1, 1, 10, 30)}},
+		{"2024-01-01T09:00:00/PT1H30M", DateTimeRange{dt(2024, 1, 1, 9, 0), dt(2024, 1, 1, 10, 30)}},
+		{"PT1H30M/2024-01-01T10:30:00", DateTimeRange{dt(2024, 1, 1, 9, 0), dt(2024, 1, 1, 10, 30)}},
+		{"2024-01-31T12:00:00/P1M", DateTimeRange{dt(2024, 1, 31, 12, 0), dt(2024, 3, 2, 12, 0)}},
+		{"P1DT2H/2024-03-01T01:00:00", DateTimeRange{dt(2024, 2, 28, 23, 0), dt(2024, 3, 1, 1, 0)}},
+	} {
+		got, err := ParseDateTimeRange(test.in)
+		if err != nil {
+			t.Errorf("ParseDateTimeRange(%q): %v", test.in, err)
+			continue
+		}
+		if got != test.want {
+			t.Errorf("ParseDateTimeRange(%q) = %v, want %v", test.in, got, test.want)
+		}
+		if back, err := ParseDateTimeRange(got.String()); err != nil || back != got {
+			t.Errorf("ParseDateTimeRange(%q) = %v, %v, want %v", got.String(), back, err, got)
+		}
+	}
+	for _, bad := range []string{"", "2024-01-01T09:00:00", "2024-01-01T09:00:00/x", "P1D/P1D", "2024-13-01T00:00:00/PT1H"} {
+		if r, err := ParseDateTimeRange(bad); err == nil {
+			t.Errorf("ParseDateTimeRange(%q) = %v, want error", bad, r)
+		}
+	}
+}
+
+func TestDateTimeRangeOverlaps(t *testing.T) {
+	r := func(d1, h1, d2, h2 int) DateTimeRange {
+		return DateTimeRange{DateTime{Date{2024, 1, d1}, Time{Hour: h1}}, DateTime{Date{2024, 1, d2}, Time{Hour: h2}}}
+	}
+	for _, test := range []struct {
+		a, b DateTimeRange
+		want DateTimeRange // the intersection, if the ranges overlap
+		ok   bool
+	}{
+		{r(1, 9, 1, 12), r(1, 11, 1, 14), r(1, 11, 1, 12), true},
+		{r(1, 22, 2, 6), r(2, 0, 2, 9), r(2, 0, 2, 6), true},
+		{r(1, 9, 3, 9), r(2, 0, 2, 1), r(2, 0, 2, 1), true},
+		{r(1, 9, 1, 12), r(1, 12, 1, 17), DateTimeRange{}, false},
+		// Empty ranges overlap nothing.
+		{r(1, 10, 1, 10), r(1, 9, 1, 12), DateTimeRange{}, false},
+		{r(1, 9, 1, 12), r(1, 10, 1, 10), DateTimeRange{}, false},
+		{r(1, 12, 1, 9), r(1, 9, 1, 12), DateTimeRange{}, false},
+	} {
+		for _, ab := range [][2]DateTimeRange{{test.a, test.b}, {test.b, test.a}} {
+			if got := ab[0].Overlaps(ab[1]); got != test.ok {
+				t.Errorf("%v.Overlaps(%v) = %t, want %t", ab[0], ab[1], got, test.ok)
+			}
+			if got, ok := ab[0].Intersect(ab[1]); got != test.want || ok != test.ok {
+				t.Errorf("%v.Intersect(%v) = %v, %t, want %v, %t", ab[0], ab[1], got, ok, test.want, test.ok)
+			}
+		}
+	}
+}
+
+func TestDateTimeRangeDurationContains(t *testing.T) {
+	r := DateTimeRange{DateTime{Date{2024, 3, 9}, Time{Hour: 22}}, DateTime{Date{2024, 3, 11}, Time{Hour: 2}}}
+	if got, want := r.Duration(), 28*time.Hour; got != want {
+		t.Errorf("%v.Duration() = %v, want %v", r, got, want)
+	}
+	for _, test := range []struct {
+		dt   DateTime
+		want bool
+	}{
+		{r.Start, true},
+		{DateTime{Date{2024, 3, 10}, Time{Hour: 12}}, true},
+		{r.End, false},
+		{DateTime{Date{2024, 3, 9}, Time{Hour: 21, Minute: 59}}, false},
+	} {
+		if got := r.Contains(test.dt); got != test.want {
+			t.Errorf("%v.Contains(%v) = %t, want %t", r, test.dt, got, test.want)
+		}
+	}
+	if empty := (DateTimeRange{Start: r.End, End: r.Start}); !empty.IsEmpty() || empty.IsValid() {
+		t.Errorf("%v: IsEmpty() = %t, IsValid() = %t, want true, false", empty, empty.IsEmpty(), empty.IsValid())
+	}
+}
+
+func TestDateTimeRangeJSON(t *testing.T) {
+	r := DateTimeRange{DateTime{Date{2024, 1, 1}, Time{Hour: 9}}, DateTime{Date{2024, 1, 1}, Time{Hour: 17, Minute: 30}}}
+	const (
+		interval = `"2024-01-01T09:00:00/2024-01-01T17:30:00"`
+		object   = `{"start":"2024-01-01T09:00:00","end":"2024-01-01T17:30:00"}`
+		spaced   = `{"start":"2024-01-01 09:00:00","end":"2024-01-01 17:30:00"}`
+	)
+	for _, test := range []struct {
+		c    Codec
+		want string
+	}{
+		{Codec{}, interval},
+		{Codec{RangeForm: ObjectForm}, object},
+		{Codec{RangeForm: ObjectForm, DateTimeSeparator: ' '}, spaced},
+		{Codec{DateTimeSeparator: ' '}, `"2024-01-01 09:00:00/2024-01-01 17:30:00"`},
+	} {
+		data, err := test.c.MarshalDateTimeRange(r)
+		if err != nil || string(data) != test.want {
+			t.Errorf("MarshalDateTimeRange with %+v = %s, %v, want %s", test.c, data, err, test.want)
+		}
+	}
+	if data, err := json.Marshal(DateTimeRangeObject(r)); err != nil || string(data) != object {
+		t.Errorf("json.Marshal(DateTimeRangeObject(%v)) = %s, %v, want %s", r, data, err, object)
+	}
+
+	for _, test := range []struct {
+		c    Codec
+		data string
+		ok   bool
+	}{
+		{Codec{}, interval, true},
+		{Codec{}, `"2024-01-01 09:00:00/2024-01-01 17:30:00"`, true},
+		{Codec{}, `"2024-01-01T09:00:00/PT8H30M"`, true},
+		{Codec{}, object, false},
+		{Codec{RangeForm: ObjectForm}, object, true},
+		{Codec{RangeForm: ObjectForm}, spaced, true},
+		{Codec{RangeForm: ObjectForm}, interval, false},
+		{Codec{RangeForm: ObjectForm}, `{"start":"2024-01-01T09:00:00"}`, false},
+		{Codec{RangeForm: ObjectForm}, `{"start":"2024-01-01T09:00:00","end":"PT8H30M"}`, false},
+	} {
+		var got DateTimeRange
+		err := test.c.UnmarshalDateTimeRange([]byte(test.data), &got)
+		if test.ok && (err != nil || got != r) {
+			t.Errorf("UnmarshalDateTimeRange(%s) with %v = %v, %v, want %v", test.data, test.c.RangeForm, got, err, r)
+		}
+		if !test.ok && err == nil {
+			t.Errorf("UnmarshalDateTimeRange(%s) with %v = %v, want error", test.data, test.c.RangeForm, got)
+		}
+	}
+	for _, data := range []string{interval, object, spaced} {
+		var o DateTimeRangeObject
+		if err := json.Unmarshal([]byte(data), &o); err != nil || DateTimeRange(o) != r {
+			t.Errorf("json.Unmarshal(%s) into DateTimeRangeObject = %v, %v, want %v", data, o, err, r)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"fmt"
+	"time"
+)
+
+// The functions in this file convert between dates and the integer day
+// counts used by other languages, so that services exchanging day counts
+// agree exactly. For example, 2020-02-29 has epoch day 18321 and day number
+// 737483, and 1970-01-01 has epoch day 0 and day number 719162.
+
+var (
+	unixEpoch = Date{Year: 1970, Month: time.January, Day: 1}
+	dayZero   = Date{Year: 1, Month: time.January, Day: 1}
+)
+
+// EpochDay returns the number of days between 1970-01-01 and d, negative
+// for dates before 1970. It agrees with toEpochDay of java.time.LocalDate.
+func (d Date) EpochDay() int {
+	return d.DaysSince(unixEpoch)
+}
+
+// DateFromEpochDay returns the date that is n days after 1970-01-01. It is
+// the inverse of EpochDay, and agrees with ofEpochDay of
+// java.time.LocalDate.
+func DateFromEpochDay(n int) Date {
+	return unixEpoch.AddDays(n)
+}
+
+// DayNumber returns the number of days between 0001-01-01 and d. It agrees
+// with the DayNumber property of the .NET DateOnly type for dates in the
+// range [MinDate, MaxDate].
+func (d Date) DayNumber() int {
+	return d.DaysSince(dayZero)
+}
+
+// DateFromDayNumber returns the date that is n days after 0001-01-01. It is
+// the inverse of DayNumber, and agrees with DateOnly.FromDayNumber in .NET.
+func DateFromDayNumber(n int) Date {
+	return dayZero.AddDays(n)
+}
+
+// DateFromOrdinal returns the date of the ISO 8601 ordinal date made up of
+// year and day, the day of the year starting from 1. It returns an error
+// if day is not in the range [1,365], or [1,366] in a leap year.
+func DateFromOrdinal(year, day int) (Date, error) {
+	if day < 1 || day > Year(year).Days() {
+		return Date{}, fmt.Errorf("civil: day %d out of range for year %d", day, year)
+	}
+	return DateFromEpochDay(daysFromCivil(year, time.January, day)), nil
+}
+
+// daysFromCivil returns the epoch day of the given year, month and day
+// without converting through time.Time. Out-of-range months and days are
+// normalized as by time.Date: October 32 is November 1.
+func daysFromCivil(year int, month time.Month, day int) int {
+	// Normalize the month, then count from March 1 so that the leap day
+	// falls at the end of each 400-year era (Howard Hinnant's algorithm).
+	m := floorMod(int(month)-1, 12)
+	year += floorDiv(int(month)-1, 12)
+	if m < 2 {
+		year--
+		m += 12
+	}
+	era := floorDiv(year, 400)
+	yoe := year - 400*era
+	doy := (153*(m-2)+2)/5 + day - 1
+	doe := 365*yoe + yoe/4 - yoe/100 + doy
+	return 146097*era + doe - 719468
+}
+
+// floorDiv returns a/b rounded towards negative infinity. b must be
+// positive.
+func floorDiv(a, b int) int {
+	q := a / b
+	if a%b < 0 {
+		q--
+	}
+	return q
+}
+
+// floorMod returns the remainder of floorDiv(a, b), in the range [0, b).
+func floorMod(a, b int) int {
+	return a - b*floorDiv(a, b)
+}
@@ -0,0 +1,96 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import "testing"
+
+func TestDayCounts(t *testing.T) {
+	// Values from java.time.LocalDate.toEpochDay and .NET DateOnly.DayNumber.
+	for _, test := range []struct {
+		d         Date
+		epochDay  int
+		dayNumber int
+	}{
+		{Date{1970, 1, 1}, 0, 719162},
+		{Date{1970, 1, 2}, 1, 719163},
+		{Date{1969, 12, 31}, -1, 719161},
+		{Date{1900, 3, 1}, -25508, 693654},
+		{Date{2000, 1, 1}, 10957, 730119},
+		{Date{2020, 2, 29}, 18321, 737483},
+		{Date{1, 1, 1}, -719162, 0},
+		{MaxDate, 2932896, 3652058},
+	} {
+		if got := test.d.EpochDay(); got != test.epochDay {
+			t.Errorf("%v.EpochDay() = %d, want %d", test.d, got, test.epochDay)
+		}
+		if got := DateFromEpochDay(test.epochDay); got != test.d {
+			t.Errorf("DateFromEpochDay(%d) = %v, want %v", test.epochDay, got, test.d)
+		}
+		if got := test.d.DayNumber(); got != test.dayNumber {
+			t.Errorf("%v.DayNumber() = %d, want %d", test.d, got, test.dayNumber)
+		}
+		if got := DateFromDayNumber(test.dayNumber); got != test.d {
+			t.Errorf("DateFromDayNumber(%d) = %v, want %v", test.dayNumber, got, test.d)
+		}
+	}
+}
+
+func TestDayCountsRoundTrip(t *testing.T) {
+	for _, d := range []Date{MinDate, MinDate.AddDays(1), MaxDate.AddDays(-1), MaxDate} {
+		if got := DateFromEpochDay(d.EpochDay()); got != d {
+			t.Errorf("DateFromEpochDay(%v.EpochDay()) = %v", d, got)
+		}
+		if got := DateFromDayNumber(d.DayNumber()); got != d {
+			t.Errorf("DateFromDayNumber(%v.DayNumber()) = %v", d, got)
+		}
+		if got := d.DayNumber() - d.EpochDay(); got != 719162 {
+			t.Errorf("%v: DayNumber - EpochDay = %d, want 719162", d, got)
+		}
+	}
+}
+
+func TestDateFromOrdinal(t *testing.T) {
+	for _, test := range []struct {
+		year, day int
+		want      Date
+	}{
+		{2024, 1, Date{2024, 1, 1}},
+		{2024, 60, Date{2024, 2, 29}},
+		{2023, 60, Date{2023, 3, 1}},
+		{2024, 366, Date{2024, 12, 31}},
+		{2023, 365, Date{2023, 12, 31}},
+		{2000, 366, Date{2000, 12, 31}},
+	} {
+		got, err := DateFromOrdinal(test.year, test.day)
+		if err != nil || got != test.want {
+			t.Errorf("DateFromOrdinal(%d, %d) = %v, %v, want %v", test.year, test.day, got, err, test.want)
+			continue
+		}
+		if got := test.want.DayOfYear(); got != test.day {
+			t.Errorf("%v.DayOfYear() = %d, want %d", test.want, got, test.day)
+		}
+	}
+	for _, test := range []struct{ year, day int }{
+		{2024, 0},
+		{2024, -1},
+		{2024, 367},
+		{2023, 366},
+		{1900, 366},
+	} {
+		if got, err := DateFromOrdinal(test.year, test.day); err == nil {
+			t.Errorf("DateFromOrdinal(%d, %d) = %v, want error", test.year, test.day, got)
+		}
+	}
+}
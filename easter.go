@@ -0,0 +1,69 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import "time"
+
+// Offsets in days from Easter Sunday of the movable feasts on which many
+// holiday calendars depend, for use as in Easter(year).AddDays(GoodFriday).
+// They apply equally to OrthodoxEaster.
+const (
+	ShroveTuesday  = -47
+	AshWednesday   = -46
+	PalmSunday     = -7
+	MaundyThursday = -3
+	GoodFriday     = -2
+	HolySaturday   = -1
+	EasterMonday   = 1
+	AscensionDay   = 39
+	WhitSunday     = 49 // Pentecost
+	WhitMonday     = 50
+	CorpusChristi  = 60
+)
+
+// Easter returns the date of Easter Sunday in the given year, as observed by
+// the Western churches, using the Gregorian computus (the "Anonymous
+// Gregorian algorithm").
+func Easter(year int) Date {
+	a := year % 19
+	b, c := year/100, year%100
+	d, e := b/4, b%4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i, k := c/4, c%4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+	return Date{Year: year, Month: time.Month(month), Day: day}
+}
+
+// OrthodoxEaster returns the date of Easter Sunday in the given year, as
+// observed by the Eastern Orthodox churches, which compute it in the Julian
+// calendar. The result is expressed, like every Date, in the Gregorian
+// calendar.
+func OrthodoxEaster(year int) Date {
+	// Meeus's Julian algorithm gives the date in the Julian calendar.
+	a, b, c := year%4, year%7, year%19
+	d := (19*c + 15) % 30
+	e := (2*a + 4*b - d + 34) % 7
+	month := (d + e + 114) / 31
+	day := (d+e+114)%31 + 1
+	// Convert to the Gregorian calendar by adding the number of days by which
+	// it had drifted from the Julian calendar.
+	drift := year/100 - year/400 - 2
+	return Date{Year: year, Month: time.Month(month), Day: day}.AddDays(drift)
+}
@@ -0,0 +1,88 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEaster(t *testing.T) {
+	for _, test := range []struct {
+		year              int
+		western, orthodox Date
+	}{
+		{1961, Date{1961, 4, 2}, Date{1961, 4, 9}},
+		{2000, Date{2000, 4, 23}, Date{2000, 4, 30}},
+		{2008, Date{2008, 3, 23}, Date{2008, 4, 27}},
+		{2010, Date{2010, 4, 4}, Date{2010, 4, 4}},
+		{2011, Date{2011, 4, 24}, Date{2011, 4, 24}},
+		{2019, Date{2019, 4, 21}, Date{2019, 4, 28}},
+		{2023, Date{2023, 4, 9}, Date{2023, 4, 16}},
+		{2024, Date{2024, 3, 31}, Date{2024, 5, 5}},
+		{2025, Date{2025, 4, 20}, Date{2025, 4, 20}},
+		{2038, Date{2038, 4, 25}, Date{2038, 4, 25}},
+	} {
+		if got := Easter(test.year); got != test.western {
+			t.Errorf("Easter(%d) = %v, want %v", test.year, got, test.western)
+		}
+		if got := OrthodoxEaster(test.year); got != test.orthodox {
+			t.Errorf("OrthodoxEaster(%d) = %v, want %v", test.year, got, test.orthodox)
+		}
+	}
+	// The earliest and latest possible dates.
+	for year, want := range map[int]Date{1818: {1818, 3, 22}, 2285: {2285, 3, 22}, 1943: {1943, 4, 25}} {
+		if got := Easter(year); got != want {
+			t.Errorf("Easter(%d) = %v, want %v", year, got, want)
+		}
+	}
+}
+
+func TestEasterRange(t *testing.T) {
+	for year := 1583; year <= 4099; year++ {
+		d := Easter(year)
+		if d.Weekday() != time.Sunday || d.Before(Date{year, 3, 22}) || d.After(Date{year, 4, 25}) {
+			t.Fatalf("Easter(%d) = %v, not a Sunday from March 22 to April 25", year, d)
+		}
+		if o := OrthodoxEaster(year); o.Weekday() != time.Sunday || o.Before(d) {
+			t.Fatalf("OrthodoxEaster(%d) = %v, not a Sunday on or after %v", year, o, d)
+		}
+	}
+}
+
+func TestMovableFeasts(t *testing.T) {
+	easter := Easter(2024)
+	for _, test := range []struct {
+		name   string
+		offset int
+		want   Date
+	}{
+		{"ShroveTuesday", ShroveTuesday, Date{2024, 2, 13}},
+		{"AshWednesday", AshWednesday, Date{2024, 2, 14}},
+		{"PalmSunday", PalmSunday, Date{2024, 3, 24}},
+		{"MaundyThursday", MaundyThursday, Date{2024, 3, 28}},
+		{"GoodFriday", GoodFriday, Date{2024, 3, 29}},
+		{"HolySaturday", HolySaturday, Date{2024, 3, 30}},
+		{"EasterMonday", EasterMonday, Date{2024, 4, 1}},
+		{"AscensionDay", AscensionDay, Date{2024, 5, 9}},
+		{"WhitSunday", WhitSunday, Date{2024, 5, 19}},
+		{"WhitMonday", WhitMonday, Date{2024, 5, 20}},
+		{"CorpusChristi", CorpusChristi, Date{2024, 5, 30}},
+	} {
+		if got := easter.AddDays(test.offset); got != test.want {
+			t.Errorf("%s 2024 = %v, want %v", test.name, got, test.want)
+		}
+	}
+}
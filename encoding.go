@@ -0,0 +1,130 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// Dates is a list of dates with a compact binary encoding, for caching and
+// transferring large date vectors.
+//
+// The encoding stores the first date and the differences between successive
+// dates as varints, run-length encoding repeated differences, so that a run
+// of consecutive dates costs a few bytes regardless of its length. Sorted
+// lists encode most compactly, but any order is preserved.
+type Dates []Date
+
+// datesEncodingVersion identifies the encoding written by MarshalBinary.
+const datesEncodingVersion = 1
+
+// MaxEncodedDates is the largest number of dates that MarshalBinary encodes
+// and UnmarshalBinary decodes. Because runs make the length of an encoding
+// unrelated to the number of dates, the limit bounds the memory that
+// decoding untrusted input can allocate.
+const MaxEncodedDates = 1 << 20
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface. It
+// returns an error if ds has more than MaxEncodedDates dates, or a date
+// outside the range [MinDate, MaxDate].
+func (ds Dates) MarshalBinary() ([]byte, error) {
+	if len(ds) > MaxEncodedDates {
+		return nil, fmt.Errorf("civil: cannot encode %d dates; the maximum is %d", len(ds), MaxEncodedDates)
+	}
+	for _, d := range ds {
+		if d.Before(MinDate) || d.After(MaxDate) {
+			return nil, fmt.Errorf("civil: cannot encode date %v out of range", d)
+		}
+	}
+	b := []byte{datesEncodingVersion}
+	b = binary.AppendUvarint(b, uint64(len(ds)))
+	if len(ds) == 0 {
+		return b, nil
+	}
+	b = binary.AppendVarint(b, int64(ds[0].EpochDay()))
+	for i := 1; i < len(ds); {
+		delta := ds[i].DaysSince(ds[i-1])
+		n := 1
+		for i+n < len(ds) && ds[i+n].DaysSince(ds[i+n-1]) == delta {
+			n++
+		}
+		b = binary.AppendVarint(b, int64(delta))
+		b = binary.AppendUvarint(b, uint64(n))
+		i += n
+	}
+	return b, nil
+}
+
+var errDatesEncoding = errors.New("civil: invalid Dates encoding")
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface. It
+// returns an error if the data encodes more than MaxEncodedDates dates, or
+// a date outside the range [MinDate, MaxDate].
+func (ds *Dates) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 || data[0] != datesEncodingVersion {
+		return errDatesEncoding
+	}
+	data = data[1:]
+	count, n := binary.Uvarint(data)
+	if n <= 0 || count > MaxEncodedDates {
+		return errDatesEncoding
+	}
+	data = data[n:]
+	// Work in epoch days, checking each date against the valid range
+	// before it is converted.
+	lo, hi := int64(MinDate.EpochDay()), int64(MaxDate.EpochDay())
+	var days []int64
+	if count > 0 {
+		first, n := binary.Varint(data)
+		if n <= 0 || first < lo || first > hi {
+			return errDatesEncoding
+		}
+		data = data[n:]
+		days = append(days, first)
+	}
+	for uint64(len(days)) < count {
+		delta, n := binary.Varint(data)
+		if n <= 0 || delta < lo-hi || delta > hi-lo {
+			return errDatesEncoding
+		}
+		data = data[n:]
+		run, n := binary.Uvarint(data)
+		if n <= 0 || run == 0 || run > count-uint64(len(days)) {
+			return errDatesEncoding
+		}
+		data = data[n:]
+		// Only the end of the run need be checked, since the dates between
+		// it and the start move steadily towards it.
+		last := days[len(days)-1]
+		if end := last + delta*int64(run); end < lo || end > hi {
+			return errDatesEncoding
+		}
+		for ; run > 0; run-- {
+			last += delta
+			days = append(days, last)
+		}
+	}
+	if len(data) != 0 {
+		return errDatesEncoding
+	}
+	r := make(Dates, len(days))
+	for i, n := range days {
+		r[i] = DateFromEpochDay(int(n))
+	}
+	*ds = r
+	return nil
+}
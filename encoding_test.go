@@ -0,0 +1,193 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestDatesBinaryRoundTrip(t *testing.T) {
+	jan1 := Date{2024, time.January, 1}
+	for _, test := range []struct {
+		name string
+		ds   Dates
+	}{
+		{"empty", Dates{}},
+		{"one", Dates{jan1}},
+		{"bounds", Dates{MinDate, MaxDate, MinDate}},
+		{"consecutive", consecutiveDates(jan1, 1000)},
+		{"unsorted", Dates{jan1, jan1.AddDays(-40), jan1.AddDays(7), jan1.AddDays(7), jan1}},
+		{"weekly", Dates{jan1, jan1.AddDays(7), jan1.AddDays(14), jan1.AddDays(21), jan1.AddDays(22)}},
+	} {
+		b, err := test.ds.MarshalBinary()
+		if err != nil {
+			t.Fatalf("%s: MarshalBinary: %v", test.name, err)
+		}
+		var got Dates
+		if err := got.UnmarshalBinary(b); err != nil {
+			t.Fatalf("%s: UnmarshalBinary: %v", test.name, err)
+		}
+		if !slices.Equal(got, test.ds) {
+			t.Errorf("%s: got %v, want %v", test.name, got, test.ds)
+		}
+	}
+}
+
+func TestDatesBinaryCompact(t *testing.T) {
+	b, err := consecutiveDates(Date{2024, time.January, 1}, 100000).MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(b) > 16 {
+		t.Errorf("encoding of a run of consecutive dates is %d bytes, want at most 16", len(b))
+	}
+}
+
+func TestDatesMarshalBinaryRejects(t *testing.T) {
+	for _, ds := range []Dates{
+		{MinDate.AddDays(-1)},
+		{MaxDate, MaxDate.AddDays(1)},
+		make(Dates, MaxEncodedDates+1),
+	} {
+		if _, err := ds.MarshalBinary(); err == nil {
+			t.Errorf("MarshalBinary of %d dates starting %v: got nil error", len(ds), ds[0])
+		}
+	}
+}
+
+func TestDatesUnmarshalBinaryRejects(t *testing.T) {
+	// encode returns an encoding of count dates from the epoch day first,
+	// followed by runs of the form {delta, length}.
+	encode := func(count uint64, first int64, runs ...[2]int64) []byte {
+		b := []byte{datesEncodingVersion}
+		b = binary.AppendUvarint(b, count)
+		b = binary.AppendVarint(b, first)
+		for _, r := range runs {
+			b = binary.AppendVarint(b, r[0])
+			b = binary.AppendUvarint(b, uint64(r[1]))
+		}
+		return b
+	}
+	for _, test := range []struct {
+		name string
+		data []byte
+	}{
+		{"empty", nil},
+		{"bad version", []byte{2, 0}},
+		{"missing count", []byte{datesEncodingVersion}},
+		{"missing first", []byte{datesEncodingVersion, 1}},
+		{"missing run", encode(2, 0)},
+		{"zero run", encode(2, 0, [2]int64{1, 0})},
+		{"run too long", encode(2, 0, [2]int64{1, 2})},
+		{"trailing data", append(encode(1, 0), 0)},
+		{"count too large", encode(1<<23, 0, [2]int64{1000, 1<<23 - 1})},
+		{"first before MinDate", encode(1, int64(MinDate.EpochDay())-1)},
+		{"first after MaxDate", encode(1, int64(MaxDate.EpochDay())+1)},
+		{"run past MaxDate", encode(5000, 0, [2]int64{1000, 4999})},
+		{"run before MinDate", encode(3, int64(MinDate.EpochDay()), [2]int64{1, 1}, [2]int64{-2, 1})},
+		{"huge delta", encode(2, 0, [2]int64{1 << 62, 1})},
+	} {
+		var ds Dates
+		if err := ds.UnmarshalBinary(test.data); err == nil {
+			t.Errorf("%s: UnmarshalBinary(%x): got %d dates and nil error", test.name, test.data, len(ds))
+		}
+	}
+}
+
+func consecutiveDates(start Date, n int) Dates {
+	ds := make(Dates, n)
+	for i := range ds {
+		ds[i] = start.AddDays(i)
+	}
+	return ds
+}
+
+// scatteredDates returns n increasing dates with irregular gaps, such as
+// the dates of a sparse event log.
+func scatteredDates(start Date, n int) Dates {
+	ds := make(Dates, n)
+	d := start
+	for i := range ds {
+		d = d.AddDays(1 + i*7919%13)
+		ds[i] = d
+	}
+	return ds
+}
+
+var benchmarkDates = []struct {
+	name string
+	ds   Dates
+}{
+	{"consecutive", consecutiveDates(Date{2000, time.January, 1}, 10000)},
+	{"scattered", scatteredDates(Date{2000, time.January, 1}, 10000)},
+}
+
+func BenchmarkDatesMarshalBinary(b *testing.B) {
+	for _, bm := range benchmarkDates {
+		ds := bm.ds
+		b.Run(bm.name, func(b *testing.B) {
+			var size int
+			for i := 0; i < b.N; i++ {
+				data, err := ds.MarshalBinary()
+				if err != nil {
+					b.Fatal(err)
+				}
+				size = len(data)
+			}
+			b.ReportMetric(float64(size), "encoded-bytes")
+		})
+	}
+}
+
+func BenchmarkDatesUnmarshalBinary(b *testing.B) {
+	for _, bm := range benchmarkDates {
+		ds := bm.ds
+		data, err := ds.MarshalBinary()
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.Run(bm.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				var got Dates
+				if err := got.UnmarshalBinary(data); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkDatesMarshalJSON measures the JSON array of strings that the
+// binary encoding replaces, for comparison.
+func BenchmarkDatesMarshalJSON(b *testing.B) {
+	for _, bm := range benchmarkDates {
+		ds := bm.ds
+		b.Run(bm.name, func(b *testing.B) {
+			var size int
+			for i := 0; i < b.N; i++ {
+				data, err := json.Marshal([]Date(ds))
+				if err != nil {
+					b.Fatal(err)
+				}
+				size = len(data)
+			}
+			b.ReportMetric(float64(size), "encoded-bytes")
+		})
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import "time"
+
+// A FiscalCalendar is a calendar whose years start on the first day of a
+// month other than, or including, January, and whose quarters and periods
+// are calendar months counted from that start.
+//
+// A fiscal year is labeled by the calendar year in which most of it falls,
+// as a RetailCalendar year is: by the year in which it starts if it starts
+// in June or earlier, and otherwise by the year in which it ends. So with an
+// April start, fiscal year 2024 runs from April 2024 to March 2025, and with
+// an October start, fiscal year 2025 runs from October 2024 to September
+// 2025.
+type FiscalCalendar struct {
+	// StartMonth is the first month of the fiscal year. The zero value
+	// means January.
+	StartMonth time.Month
+}
+
+// A FiscalDate identifies the position of a date in a fiscal calendar.
+type FiscalDate struct {
+	Year    int // The fiscal year, labeled as described for FiscalCalendar.
+	Quarter int // The quarter of the fiscal year; range [1-4]
+	Period  int // The month of the fiscal year; range [1-12]
+}
+
+// start returns the first month of the fiscal year y.
+func (c FiscalCalendar) start(y int) YearMonth {
+	m := max(c.StartMonth, time.January)
+	if m > time.June {
+		y--
+	}
+	return YearMonth{Year: y, Month: m}
+}
+
+// Year returns the dates of the fiscal year y.
+func (c FiscalCalendar) Year(y int) DateRange {
+	first := c.start(y).FirstDate()
+	return DateRange{Start: first, End: first.AddMonths(12).AddDays(-1)}
+}
+
+// Quarter returns the dates of quarter q, in the range [1-4], of the fiscal
+// year y.
+func (c FiscalCalendar) Quarter(y, q int) DateRange {
+	first := c.start(y).FirstDate().AddMonths(3 * (q - 1))
+	return DateRange{Start: first, End: first.AddMonths(3).AddDays(-1)}
+}
+
+// Period returns the dates of period p, the p'th month in the range [1-12],
+// of the fiscal year y.
+func (c FiscalCalendar) Period(y, p int) DateRange {
+	first := c.start(y).FirstDate().AddMonths(p - 1)
+	return DateRange{Start: first, End: first.AddMonths(1).AddDays(-1)}
+}
+
+// Of returns the position of d in the calendar.
+func (c FiscalCalendar) Of(d Date) FiscalDate {
+	y, i := d.Year, d.monthIndex()
+	if first := c.start(y).FirstDate().monthIndex(); i < first {
+		y--
+	} else if i >= first+12 {
+		y++
+	}
+	p := i - c.start(y).FirstDate().monthIndex() + 1
+	return FiscalDate{Year: y, Quarter: (p-1)/3 + 1, Period: p}
+}
@@ -0,0 +1,105 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFiscalCalendarYear(t *testing.T) {
+	for _, test := range []struct {
+		start time.Month
+		year  int
+		want  DateRange
+	}{
+		{0, 2024, DateRange{Date{2024, 1, 1}, Date{2024, 12, 31}}},
+		{time.January, 2024, DateRange{Date{2024, 1, 1}, Date{2024, 12, 31}}},
+		// India, Japan and the United Kingdom.
+		{time.April, 2024, DateRange{Date{2024, 4, 1}, Date{2025, 3, 31}}},
+		// Australia.
+		{time.July, 2025, DateRange{Date{2024, 7, 1}, Date{2025, 6, 30}}},
+		// The United States federal government.
+		{time.October, 2025, DateRange{Date{2024, 10, 1}, Date{2025, 9, 30}}},
+		{time.June, 2024, DateRange{Date{2024, 6, 1}, Date{2025, 5, 31}}},
+		{time.March, 2023, DateRange{Date{2023, 3, 1}, Date{2024, 2, 29}}},
+	} {
+		c := FiscalCalendar{StartMonth: test.start}
+		if got := c.Year(test.year); got != test.want {
+			t.Errorf("%+v.Year(%d) = %v, want %v", c, test.year, got, test.want)
+		}
+	}
+}
+
+func TestFiscalCalendarQuarterPeriod(t *testing.T) {
+	c := FiscalCalendar{StartMonth: time.October}
+	for _, test := range []struct {
+		q    int
+		want DateRange
+	}{
+		{1, DateRange{Date{2024, 10, 1}, Date{2024, 12, 31}}},
+		{2, DateRange{Date{2025, 1, 1}, Date{2025, 3, 31}}},
+		{4, DateRange{Date{2025, 7, 1}, Date{2025, 9, 30}}},
+	} {
+		if got := c.Quarter(2025, test.q); got != test.want {
+			t.Errorf("%+v.Quarter(2025, %d) = %v, want %v", c, test.q, got, test.want)
+		}
+	}
+	for _, test := range []struct {
+		p    int
+		want DateRange
+	}{
+		{1, DateRange{Date{2024, 10, 1}, Date{2024, 10, 31}}},
+		{5, DateRange{Date{2025, 2, 1}, Date{2025, 2, 28}}},
+		{12, DateRange{Date{2025, 9, 1}, Date{2025, 9, 30}}},
+	} {
+		if got := c.Period(2025, test.p); got != test.want {
+			t.Errorf("%+v.Period(2025, %d) = %v, want %v", c, test.p, got, test.want)
+		}
+	}
+}
+
+func TestFiscalCalendarOf(t *testing.T) {
+	for _, test := range []struct {
+		start time.Month
+		d     Date
+		want  FiscalDate
+	}{
+		{0, Date{2024, 8, 15}, FiscalDate{2024, 3, 8}},
+		{time.April, Date{2024, 4, 1}, FiscalDate{2024, 1, 1}},
+		{time.April, Date{2025, 3, 31}, FiscalDate{2024, 4, 12}},
+		{time.April, Date{2024, 12, 25}, FiscalDate{2024, 3, 9}},
+		{time.October, Date{2024, 10, 1}, FiscalDate{2025, 1, 1}},
+		{time.October, Date{2024, 9, 30}, FiscalDate{2024, 4, 12}},
+		{time.July, Date{2025, 1, 1}, FiscalDate{2025, 3, 7}},
+	} {
+		c := FiscalCalendar{StartMonth: test.start}
+		if got := c.Of(test.d); got != test.want {
+			t.Errorf("%+v.Of(%v) = %+v, want %+v", c, test.d, got, test.want)
+		}
+	}
+}
+
+func TestFiscalCalendarOfConsistent(t *testing.T) {
+	for m := time.January; m <= time.December; m++ {
+		c := FiscalCalendar{StartMonth: m}
+		for d := (Date{2020, 1, 1}); d.Before(Date{2027, 1, 1}); d = d.AddDays(1) {
+			fd := c.Of(d)
+			if !c.Year(fd.Year).Contains(d) || !c.Quarter(fd.Year, fd.Quarter).Contains(d) || !c.Period(fd.Year, fd.Period).Contains(d) {
+				t.Fatalf("%+v.Of(%v) = %+v, whose ranges do not contain the date", c, d, fd)
+			}
+		}
+	}
+}
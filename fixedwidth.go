@@ -0,0 +1,119 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// The functions in this file parse and format the fixed-width date
+// encodings of mainframe and EDI feeds. Parsing requires exactly the given
+// number of decimal digits.
+
+// ParseCYYMMDD parses a seven-digit date with a century digit, as used by
+// IBM midrange systems: C is the number of centuries after 1900, so
+// "1200229" is 2020-02-29 and "0991231" is 1999-12-31.
+func ParseCYYMMDD(s string) (Date, error) {
+	n, err := fixedDigits(s, 7, "CYYMMDD")
+	if err != nil {
+		return Date{}, err
+	}
+	return validDate(s, 1900+n/10000, time.Month(n/100%100), n%100)
+}
+
+// FormatCYYMMDD formats d as described in ParseCYYMMDD. It reports an error
+// if d is not in the years 1900 through 2899.
+func FormatCYYMMDD(d Date) (string, error) {
+	if d.Year < 1900 || d.Year > 2899 {
+		return "", fmt.Errorf("civil: %v cannot be formatted as CYYMMDD", d)
+	}
+	return fmt.Sprintf("%d%02d%02d%02d", (d.Year-1900)/100, d.Year%100, d.Month, d.Day), nil
+}
+
+// ParseYYDDD parses a five-digit ordinal date consisting of a two-digit
+// year and a three-digit day of the year. The two-digit year is taken to be
+// the year in the range [pivot, pivot+99] that ends in those digits: with a
+// pivot of 1950, "20060" is 2020-02-29 and "99365" is 1999-12-31.
+func ParseYYDDD(s string, pivot int) (Date, error) {
+	n, err := fixedDigits(s, 5, "YYDDD")
+	if err != nil {
+		return Date{}, err
+	}
+	year := pivot + ((n/1000-pivot)%100+100)%100
+	return ordinalDate(s, year, n%1000)
+}
+
+// FormatYYDDD formats d as described in ParseYYDDD. The century is lost.
+func FormatYYDDD(d Date) string {
+	return fmt.Sprintf("%02d%03d", (d.Year%100+100)%100, d.YearDay())
+}
+
+// ParseYYYYDDD parses a seven-digit ordinal date consisting of a four-digit
+// year and a three-digit day of the year, so "2020060" is 2020-02-29.
+func ParseYYYYDDD(s string) (Date, error) {
+	n, err := fixedDigits(s, 7, "YYYYDDD")
+	if err != nil {
+		return Date{}, err
+	}
+	return ordinalDate(s, n/1000, n%1000)
+}
+
+// FormatYYYYDDD formats d as described in ParseYYYYDDD.
+func FormatYYYYDDD(d Date) string {
+	return fmt.Sprintf("%04d%03d", d.Year, d.YearDay())
+}
+
+// ParseDDMMYYYY parses an eight-digit day-first date, so "29022020" is
+// 2020-02-29.
+func ParseDDMMYYYY(s string) (Date, error) {
+	n, err := fixedDigits(s, 8, "DDMMYYYY")
+	if err != nil {
+		return Date{}, err
+	}
+	return validDate(s, n%10000, time.Month(n/10000%100), n/1000000)
+}
+
+// FormatDDMMYYYY formats d as described in ParseDDMMYYYY.
+func FormatDDMMYYYY(d Date) string {
+	return fmt.Sprintf("%02d%02d%04d", d.Day, d.Month, d.Year)
+}
+
+// fixedDigits parses s, which must consist of exactly width decimal digits.
+func fixedDigits(s string, width int, format string) (int, error) {
+	if len(s) != width || !isDigits(s) {
+		return 0, fmt.Errorf("civil: cannot parse %q as %s", s, format)
+	}
+	return strconv.Atoi(s)
+}
+
+// validDate returns the given date, or an error naming s if it is invalid.
+func validDate(s string, year int, month time.Month, day int) (Date, error) {
+	d := Date{Year: year, Month: month, Day: day}
+	if !d.IsValid() {
+		return Date{}, fmt.Errorf("civil: %q is not a valid date", s)
+	}
+	return d, nil
+}
+
+// ordinalDate returns the given day of year, or an error naming s if there
+// is no such day.
+func ordinalDate(s string, year, day int) (Date, error) {
+	if day < 1 || day > Year(year).Days() {
+		return Date{}, fmt.Errorf("civil: %q is not a valid date", s)
+	}
+	return Year(year).FirstDate().AddDays(day - 1), nil
+}
@@ -0,0 +1,103 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import "testing"
+
+func TestFixedWidthRoundTrip(t *testing.T) {
+	for _, test := range []struct {
+		d                       Date
+		cyymmdd, yyddd, yyyyddd string
+		ddmmyyyy                string
+	}{
+		{Date{2020, 2, 29}, "1200229", "20060", "2020060", "29022020"},
+		{Date{1999, 12, 31}, "0991231", "99365", "1999365", "31121999"},
+		{Date{1950, 1, 1}, "0500101", "50001", "1950001", "01011950"},
+		{Date{2049, 12, 31}, "1491231", "49365", "2049365", "31122049"},
+		{Date{2024, 12, 31}, "1241231", "24366", "2024366", "31122024"},
+	} {
+		if s, err := FormatCYYMMDD(test.d); err != nil || s != test.cyymmdd {
+			t.Errorf("FormatCYYMMDD(%v) = %q, %v, want %q", test.d, s, err, test.cyymmdd)
+		}
+		if got, err := ParseCYYMMDD(test.cyymmdd); err != nil || got != test.d {
+			t.Errorf("ParseCYYMMDD(%q) = %v, %v, want %v", test.cyymmdd, got, err, test.d)
+		}
+		if s := FormatYYDDD(test.d); s != test.yyddd {
+			t.Errorf("FormatYYDDD(%v) = %q, want %q", test.d, s, test.yyddd)
+		}
+		if got, err := ParseYYDDD(test.yyddd, 1950); err != nil || got != test.d {
+			t.Errorf("ParseYYDDD(%q, 1950) = %v, %v, want %v", test.yyddd, got, err, test.d)
+		}
+		if s := FormatYYYYDDD(test.d); s != test.yyyyddd {
+			t.Errorf("FormatYYYYDDD(%v) = %q, want %q", test.d, s, test.yyyyddd)
+		}
+		if got, err := ParseYYYYDDD(test.yyyyddd); err != nil || got != test.d {
+			t.Errorf("ParseYYYYDDD(%q) = %v, %v, want %v", test.yyyyddd, got, err, test.d)
+		}
+		if s := FormatDDMMYYYY(test.d); s != test.ddmmyyyy {
+			t.Errorf("FormatDDMMYYYY(%v) = %q, want %q", test.d, s, test.ddmmyyyy)
+		}
+		if got, err := ParseDDMMYYYY(test.ddmmyyyy); err != nil || got != test.d {
+			t.Errorf("ParseDDMMYYYY(%q) = %v, %v, want %v", test.ddmmyyyy, got, err, test.d)
+		}
+	}
+}
+
+func TestParseYYDDDPivot(t *testing.T) {
+	for _, test := range []struct {
+		s     string
+		pivot int
+		want  Date
+	}{
+		{"20060", 2000, Date{2020, 2, 29}},
+		{"99365", 2000, Date{2099, 12, 31}},
+		{"00001", 1901, Date{2000, 1, 1}},
+		{"01001", 1901, Date{1901, 1, 1}},
+	} {
+		if got, err := ParseYYDDD(test.s, test.pivot); err != nil || got != test.want {
+			t.Errorf("ParseYYDDD(%q, %d) = %v, %v, want %v", test.s, test.pivot, got, err, test.want)
+		}
+	}
+}
+
+func TestFixedWidthRejects(t *testing.T) {
+	for _, test := range []struct {
+		name  string
+		parse func(string) (Date, error)
+		s     string
+	}{
+		{"ParseCYYMMDD", ParseCYYMMDD, "120229"},
+		{"ParseCYYMMDD", ParseCYYMMDD, "1210229"},
+		{"ParseCYYMMDD", ParseCYYMMDD, "12002x9"},
+		{"ParseCYYMMDD", ParseCYYMMDD, "+200229"},
+		{"ParseYYYYDDD", ParseYYYYDDD, "2021366"},
+		{"ParseYYYYDDD", ParseYYYYDDD, "2021000"},
+		{"ParseYYYYDDD", ParseYYYYDDD, "202106"},
+		{"ParseDDMMYYYY", ParseDDMMYYYY, "31042020"},
+		{"ParseDDMMYYYY", ParseDDMMYYYY, "2902202"},
+		{"ParseDDMMYYYY", ParseDDMMYYYY, "29-02-2020"},
+		{"ParseYYDDD", func(s string) (Date, error) { return ParseYYDDD(s, 1950) }, "21366"},
+		{"ParseYYDDD", func(s string) (Date, error) { return ParseYYDDD(s, 1950) }, "2106"},
+	} {
+		if got, err := test.parse(test.s); err == nil {
+			t.Errorf("%s(%q) = %v, want error", test.name, test.s, got)
+		}
+	}
+	for _, d := range []Date{{1899, 12, 31}, {2900, 1, 1}} {
+		if s, err := FormatCYYMMDD(d); err == nil {
+			t.Errorf("FormatCYYMMDD(%v) = %q, want error", d, s)
+		}
+	}
+}
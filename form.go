@@ -0,0 +1,80 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+)
+
+// Converters returns functions that parse a Date, a Time and a DateTime
+// with p, keyed by a zero value of each type. They have the signature of
+// the converters of github.com/gorilla/schema and similar reflection-based
+// form decoders, and can be registered with
+//
+//	for v, conv := range civil.Parser{AllowUnpadded: true}.Converters() {
+//		decoder.RegisterConverter(v, conv)
+//	}
+//
+// A converter returns the zero value for an empty string and an invalid
+// reflect.Value, which such decoders report as a conversion error, for a
+// string that p cannot parse. Use FormValue to obtain the parse error.
+func (p Parser) Converters() map[any]func(string) reflect.Value {
+	return map[any]func(string) reflect.Value{
+		Date{}:     converter(p.ParseDate),
+		Time{}:     converter(p.ParseTime),
+		DateTime{}: converter(p.ParseDateTime),
+	}
+}
+
+// converter adapts a parse function to a form decoder converter.
+func converter[T civilType](parse func(string) (T, error)) func(string) reflect.Value {
+	return func(s string) reflect.Value {
+		var v T
+		if s != "" {
+			var err error
+			if v, err = parse(s); err != nil {
+				return reflect.Value{}
+			}
+		}
+		return reflect.ValueOf(v)
+	}
+}
+
+// FormValue parses the first value associated with key in values with p.
+// It returns the zero value if the key is absent or its value is empty.
+// The error, if any, names the key as well as the reason the value failed
+// to parse.
+func FormValue[T civilType](p Parser, values url.Values, key string) (T, error) {
+	var v T
+	s := values.Get(key)
+	if s == "" {
+		return v, nil
+	}
+	var err error
+	switch dst := any(&v).(type) {
+	case *Date:
+		*dst, err = p.ParseDate(s)
+	case *Time:
+		*dst, err = p.ParseTime(s)
+	case *DateTime:
+		*dst, err = p.ParseDateTime(s)
+	}
+	if err != nil {
+		return v, fmt.Errorf("civil: form field %q: %v", key, err)
+	}
+	return v, nil
+}
@@ -0,0 +1,87 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestConverters(t *testing.T) {
+	convs := Parser{AllowUnpadded: true}.Converters()
+	for _, test := range []struct {
+		zero any
+		s    string
+		want any // if nil, expect an invalid reflect.Value
+	}{
+		{Date{}, "2024-3-5", Date{2024, 3, 5}},
+		{Date{}, "", Date{}},
+		{Date{}, "2024-02-30", nil},
+		{Time{}, "9:07:03", Time{9, 7, 3, 0}},
+		{Time{}, "", Time{}},
+		{Time{}, "25:00:00", nil},
+		{DateTime{}, "2024-03-05T09:07:03", DateTime{Date{2024, 3, 5}, Time{9, 7, 3, 0}}},
+		{DateTime{}, "x", nil},
+	} {
+		conv, ok := convs[test.zero]
+		if !ok {
+			t.Fatalf("Converters() has no converter for %T", test.zero)
+		}
+		v := conv(test.s)
+		if test.want == nil {
+			if v.IsValid() {
+				t.Errorf("%T converter(%q) = %v, want an invalid Value", test.zero, test.s, v)
+			}
+			continue
+		}
+		if !v.IsValid() || v.Interface() != test.want {
+			t.Errorf("%T converter(%q) = %v, want %v", test.zero, test.s, v, test.want)
+		}
+	}
+	if got := len(convs); got != 3 {
+		t.Errorf("len(Converters()) = %d, want 3", got)
+	}
+}
+
+func TestFormValue(t *testing.T) {
+	values := url.Values{
+		"born":  {"1990-07-14", "2000-01-01"},
+		"at":    {"09:07"},
+		"when":  {"2024-03-05T09:07:03"},
+		"empty": {""},
+		"bad":   {"1990-02-30"},
+	}
+	p := Parser{}
+	if got, err := FormValue[Date](p, values, "born"); err != nil || got != (Date{1990, 7, 14}) {
+		t.Errorf("FormValue[Date](born) = %v, %v, want 1990-07-14", got, err)
+	}
+	if got, err := FormValue[Date](p, values, "missing"); err != nil || got != (Date{}) {
+		t.Errorf("FormValue[Date](missing) = %v, %v, want the zero Date", got, err)
+	}
+	if got, err := FormValue[Time](p, values, "empty"); err != nil || got != (Time{}) {
+		t.Errorf("FormValue[Time](empty) = %v, %v, want the zero Time", got, err)
+	}
+	if got, err := FormValue[DateTime](p, values, "when"); err != nil || got != (DateTime{Date{2024, 3, 5}, Time{9, 7, 3, 0}}) {
+		t.Errorf("FormValue[DateTime](when) = %v, %v", got, err)
+	}
+	_, err := FormValue[Date](p, values, "bad")
+	if err == nil || !strings.Contains(err.Error(), `"bad"`) {
+		t.Errorf("FormValue[Date](bad) error = %v, want one naming the field", err)
+	}
+	if _, err := FormValue[Time](p, values, "at"); err == nil {
+		t.Error("FormValue[Time](at) succeeded without seconds, want error")
+	}
+}
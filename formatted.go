@@ -0,0 +1,115 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import "time"
+
+// A Layout supplies a layout, in the form used by time.Time.Format, as a
+// type. Implementations are typically empty structs, so that a Layout can
+// be bound to a Formatted type.
+type Layout interface {
+	Layout() string
+}
+
+// Layouts for use with Formatted.
+type (
+	// CompactDateLayout formats a date as "20060102".
+	CompactDateLayout struct{}
+	// CompactDateTimeLayout formats a datetime as "20060102T150405".
+	CompactDateTimeLayout struct{}
+	// USDateLayout formats a date as "01/02/2006".
+	USDateLayout struct{}
+	// EuropeanDateLayout formats a date as "02.01.2006".
+	EuropeanDateLayout struct{}
+	// ClockLayout formats a time as "15:04", without seconds.
+	ClockLayout struct{}
+	// SecondsLayout formats a time as "15:04:05", without a fraction.
+	SecondsLayout struct{}
+	// MillisecondsLayout formats a time as "15:04:05.000", with exactly
+	// three digits of fraction.
+	MillisecondsLayout struct{}
+	// MicrosecondsLayout formats a time as "15:04:05.000000", with exactly
+	// six digits of fraction.
+	MicrosecondsLayout struct{}
+)
+
+func (CompactDateLayout) Layout() string     { return BasicDateLayout }
+func (CompactDateTimeLayout) Layout() string { return "20060102T150405" }
+func (USDateLayout) Layout() string          { return "01/02/2006" }
+func (EuropeanDateLayout) Layout() string    { return "02.01.2006" }
+func (ClockLayout) Layout() string           { return "15:04" }
+func (SecondsLayout) Layout() string         { return "15:04:05" }
+func (MillisecondsLayout) Layout() string    { return "15:04:05.000" }
+func (MicrosecondsLayout) Layout() string    { return "15:04:05.000000" }
+
+// civilType is the set of types that Formatted can wrap.
+type civilType interface {
+	Date | Time | DateTime
+}
+
+// Formatted wraps a civil value whose text and JSON representation uses the
+// layout L instead of the representation of T. It allows a struct to hold
+// fields in different formats without a custom type for each, as in
+//
+//	type Record struct {
+//		Born    civil.Formatted[civil.Date, civil.CompactDateLayout]
+//		Updated civil.DateTime
+//	}
+//
+// Fields of the value that the layout does not include are zero when the
+// value is parsed and ignored when it is formatted.
+type Formatted[T civilType, L Layout] struct {
+	Value T
+}
+
+// String returns the value formatted with the layout L.
+func (f Formatted[T, L]) String() string {
+	var l L
+	var t time.Time
+	switch v := any(f.Value).(type) {
+	case Date:
+		t = v.In(time.UTC)
+	case Time:
+		t = DateTime{Time: v}.In(time.UTC)
+	case DateTime:
+		t = v.In(time.UTC)
+	}
+	return t.Format(l.Layout())
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// The output is the result of f.String().
+func (f Formatted[T, L]) MarshalText() ([]byte, error) {
+	return []byte(f.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// The value is expected to be in the layout L.
+func (f *Formatted[T, L]) UnmarshalText(data []byte) error {
+	var l L
+	t, err := time.Parse(l.Layout(), string(data))
+	if err != nil {
+		return err
+	}
+	switch v := any(&f.Value).(type) {
+	case *Date:
+		*v = DateOf(t)
+	case *Time:
+		*v = TimeOf(t)
+	case *DateTime:
+		*v = DateTimeOf(t)
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestFormatted(t *testing.T) {
+	d := Date{2024, 3, 5}
+	tm := Time{9, 7, 3, 120450000}
+	dt := DateTime{d, tm}
+	for _, test := range []struct {
+		f    interface{ String() string }
+		want string
+		// parse parses the string back and returns the value and the value
+		// expected after dropping the fields the layout does not include.
+		parse func(string) (got, want any, err error)
+	}{
+		{Formatted[Date, CompactDateLayout]{d}, "20240305", parseFormatted[Date, CompactDateLayout](d)},
+		{Formatted[Date, USDateLayout]{d}, "03/05/2024", parseFormatted[Date, USDateLayout](d)},
+		{Formatted[Date, EuropeanDateLayout]{d}, "05.03.2024", parseFormatted[Date, EuropeanDateLayout](d)},
+		{Formatted[DateTime, CompactDateTimeLayout]{dt}, "20240305T090703", parseFormatted[DateTime, CompactDateTimeLayout](DateTime{d, Time{9, 7, 3, 0}})},
+		{Formatted[Time, ClockLayout]{tm}, "09:07", parseFormatted[Time, ClockLayout](Time{9, 7, 0, 0})},
+		{Formatted[Time, SecondsLayout]{tm}, "09:07:03", parseFormatted[Time, SecondsLayout](Time{9, 7, 3, 0})},
+		{Formatted[Time, MillisecondsLayout]{tm}, "09:07:03.120", parseFormatted[Time, MillisecondsLayout](Time{9, 7, 3, 120000000})},
+		{Formatted[Time, MicrosecondsLayout]{tm}, "09:07:03.120450", parseFormatted[Time, MicrosecondsLayout](Time{9, 7, 3, 120450000})},
+	} {
+		s := test.f.String()
+		if s != test.want {
+			t.Errorf("%T.String() = %q, want %q", test.f, s, test.want)
+			continue
+		}
+		if got, want, err := test.parse(s); err != nil || got != want {
+			t.Errorf("%T.UnmarshalText(%q) = %v, %v, want %v", test.f, s, got, err, want)
+		}
+	}
+}
+
+// parseFormatted returns a function that unmarshals a string into a
+// Formatted[T, L] and returns the value with want.
+func parseFormatted[T civilType, L Layout](want T) func(string) (any, any, error) {
+	return func(s string) (any, any, error) {
+		var f Formatted[T, L]
+		err := f.UnmarshalText([]byte(s))
+		return f.Value, want, err
+	}
+}
+
+func TestFormattedJSON(t *testing.T) {
+	type record struct {
+		Born    Formatted[Date, CompactDateLayout]
+		Updated DateTime
+	}
+	r := record{Formatted[Date, CompactDateLayout]{Date{1990, 7, 14}}, DateTime{Date{2024, 3, 5}, Time{9, 7, 3, 0}}}
+	data, err := json.Marshal(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(data), `{"Born":"19900714","Updated":"2024-03-05T09:07:03"}`; got != want {
+		t.Errorf("json.Marshal(%v) = %s, want %s", r, got, want)
+	}
+	var got record
+	if err := json.Unmarshal(data, &got); err != nil || got != r {
+		t.Errorf("json.Unmarshal(%s) = %v, %v, want %v", data, got, err, r)
+	}
+
+	for _, s := range []string{"1990-07-14", "19900230", "1990071", ""} {
+		var f Formatted[Date, CompactDateLayout]
+		if err := f.UnmarshalText([]byte(s)); err == nil {
+			t.Errorf("UnmarshalText(%q) = %v, want error", s, f.Value)
+		}
+	}
+}
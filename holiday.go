@@ -0,0 +1,87 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"slices"
+	"sync"
+)
+
+// A HolidayCalendar reports the public or company holidays of a market,
+// country or organization. Implementations must be safe for concurrent use.
+type HolidayCalendar interface {
+	// IsHoliday reports whether d is a holiday.
+	IsHoliday(d Date) bool
+
+	// HolidaysIn returns the holidays in the given year, in ascending
+	// order.
+	HolidaysIn(year int) []Date
+}
+
+// A HolidayList is a HolidayCalendar of a fixed set of dates, which must be
+// sorted in ascending order without duplicates, as returned by DedupDates.
+type HolidayList []Date
+
+// IsHoliday reports whether d is in the list.
+func (l HolidayList) IsHoliday(d Date) bool {
+	return ContainsDate(l, d)
+}
+
+// HolidaysIn returns the dates of the list in the given year.
+func (l HolidayList) HolidaysIn(year int) []Date {
+	i, _ := slices.BinarySearchFunc(l, Year(year).FirstDate(), Date.Compare)
+	j, _ := slices.BinarySearchFunc(l, Year(year+1).FirstDate(), Date.Compare)
+	return slices.Clone(l[i:j])
+}
+
+var (
+	holidayCalendarsMu sync.RWMutex
+	holidayCalendars   = map[string]HolidayCalendar{}
+)
+
+// RegisterHolidayCalendar registers the calendar c under name, such as
+// "US-NYSE" or "DE", replacing any calendar already registered under that
+// name. Registering a nil calendar removes the name.
+func RegisterHolidayCalendar(name string, c HolidayCalendar) {
+	holidayCalendarsMu.Lock()
+	defer holidayCalendarsMu.Unlock()
+	if c == nil {
+		delete(holidayCalendars, name)
+		return
+	}
+	holidayCalendars[name] = c
+}
+
+// LookupHolidayCalendar returns the calendar registered under name. It
+// reports false if there is none.
+func LookupHolidayCalendar(name string) (HolidayCalendar, bool) {
+	holidayCalendarsMu.RLock()
+	defer holidayCalendarsMu.RUnlock()
+	c, ok := holidayCalendars[name]
+	return c, ok
+}
+
+// HolidayCalendars returns the names of the registered calendars, in
+// ascending order.
+func HolidayCalendars() []string {
+	holidayCalendarsMu.RLock()
+	defer holidayCalendarsMu.RUnlock()
+	var names []string
+	for name := range holidayCalendars {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
@@ -0,0 +1,87 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestHolidayList(t *testing.T) {
+	l := HolidayList{
+		{2023, 12, 25},
+		{2024, 1, 1},
+		{2024, 12, 25},
+		{2024, 12, 31},
+		{2025, 1, 1},
+	}
+	for _, test := range []struct {
+		d    Date
+		want bool
+	}{
+		{Date{2024, 1, 1}, true},
+		{Date{2024, 12, 31}, true},
+		{Date{2024, 1, 2}, false},
+		{Date{2022, 12, 25}, false},
+	} {
+		if got := l.IsHoliday(test.d); got != test.want {
+			t.Errorf("IsHoliday(%v) = %t, want %t", test.d, got, test.want)
+		}
+	}
+	for _, test := range []struct {
+		year int
+		want []Date
+	}{
+		{2023, []Date{{2023, 12, 25}}},
+		{2024, []Date{{2024, 1, 1}, {2024, 12, 25}, {2024, 12, 31}}},
+		{2025, []Date{{2025, 1, 1}}},
+		{2026, nil},
+	} {
+		got := l.HolidaysIn(test.year)
+		if !slices.Equal(got, test.want) {
+			t.Errorf("HolidaysIn(%d) = %v, want %v", test.year, got, test.want)
+		}
+		if len(got) > 0 {
+			got[0] = Date{}
+			if l.IsHoliday(Date{}) {
+				t.Errorf("HolidaysIn(%d) shares storage with the list", test.year)
+			}
+		}
+	}
+}
+
+func TestHolidayCalendarRegistry(t *testing.T) {
+	const name = "XX-TEST"
+	if _, ok := LookupHolidayCalendar(name); ok {
+		t.Fatalf("LookupHolidayCalendar(%q) found a calendar before registration", name)
+	}
+	l := HolidayList{{2024, 7, 4}}
+	RegisterHolidayCalendar(name, l)
+	c, ok := LookupHolidayCalendar(name)
+	if !ok || !c.IsHoliday(Date{2024, 7, 4}) {
+		t.Errorf("LookupHolidayCalendar(%q) = %v, %t", name, c, ok)
+	}
+	if !slices.Contains(HolidayCalendars(), name) || !slices.IsSorted(HolidayCalendars()) {
+		t.Errorf("HolidayCalendars() = %v, want a sorted list including %q", HolidayCalendars(), name)
+	}
+	bc := &BusinessCalendar{HolidayCalendar: c}
+	if bc.IsWorkingDay(Date{2024, 7, 4}) {
+		t.Error("a BusinessCalendar with the registered calendar treats 2024-07-04 as a working day")
+	}
+	RegisterHolidayCalendar(name, nil)
+	if _, ok := LookupHolidayCalendar(name); ok {
+		t.Errorf("LookupHolidayCalendar(%q) found a calendar after removal", name)
+	}
+}
@@ -0,0 +1,221 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// An ICalendarHolidays is a HolidayCalendar read from an iCalendar (RFC 5545)
+// file, such as a holiday feed exported from a calendar application. Every
+// date covered by an all-day event is a holiday. Build one with
+// ParseICalendar.
+type ICalendarHolidays struct {
+	dates []Date     // the dates of events that do not recur
+	rules []icalRule // the events that recur
+
+	mu    sync.Mutex
+	years map[int][]Date // the holidays of each year computed so far
+}
+
+// An icalRule is a recurring all-day event.
+type icalRule struct {
+	start   Date
+	days    int // the length of each occurrence
+	rule    RRule
+	exdates []Date
+}
+
+// ParseICalendar reads the VEVENT components of an iCalendar file from r and
+// returns the holidays they describe. An event's dates run from its DTSTART
+// up to, but not including, its DTEND, or for the length of its DURATION, or
+// for a single day if it has neither. Recurring events are expanded
+// according to their RRULE, in the subset that ParseRRule accepts, less
+// any EXDATE.
+//
+// Events that start at a time of day, rather than on a date, and cancelled
+// events, are ignored.
+func ParseICalendar(r io.Reader) (*ICalendarHolidays, error) {
+	c := &ICalendarHolidays{years: map[int][]Date{}}
+	var lines []string
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		line := strings.TrimRight(sc.Text(), "\r")
+		if len(lines) > 0 && line != "" && (line[0] == ' ' || line[0] == '\t') {
+			// Unfold a continuation line.
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+
+	var ev *icalEvent
+	for i, line := range lines {
+		name, value, ok := splitContentLine(line)
+		if !ok {
+			continue
+		}
+		switch {
+		case name == "BEGIN" && strings.EqualFold(value, "VEVENT"):
+			ev = &icalEvent{}
+		case name == "END" && strings.EqualFold(value, "VEVENT") && ev != nil:
+			if err := c.add(ev); err != nil {
+				return nil, fmt.Errorf("civil: iCalendar event ending on line %d: %v", i+1, err)
+			}
+			ev = nil
+		case ev != nil:
+			if name == "EXDATE" {
+				ev.exdates = append(ev.exdates, strings.Split(value, ",")...)
+			} else {
+				ev.props = append(ev.props, [2]string{name, value})
+			}
+		}
+	}
+	slices.SortFunc(c.dates, Date.Compare)
+	c.dates = slices.Compact(c.dates)
+	return c, nil
+}
+
+// An icalEvent holds the properties of a VEVENT as they are read.
+type icalEvent struct {
+	props   [][2]string // name and value
+	exdates []string
+}
+
+// get returns the value of the named property of the event.
+func (ev *icalEvent) get(name string) string {
+	for _, p := range ev.props {
+		if p[0] == name {
+			return p[1]
+		}
+	}
+	return ""
+}
+
+// splitContentLine splits an iCalendar content line of the form
+// NAME;PARAMS:VALUE into its name, in upper case, and its value.
+func splitContentLine(line string) (name, value string, ok bool) {
+	head, value, ok := strings.Cut(line, ":")
+	if !ok {
+		return "", "", false
+	}
+	name, _, _ = strings.Cut(head, ";")
+	return strings.ToUpper(name), value, true
+}
+
+// add adds the dates of the event ev to c.
+func (c *ICalendarHolidays) add(ev *icalEvent) error {
+	dtstart := ev.get("DTSTART")
+	if dtstart == "" || strings.ContainsAny(dtstart, "Tt") || strings.EqualFold(ev.get("STATUS"), "CANCELLED") {
+		return nil
+	}
+	start, err := parseICalDate(dtstart)
+	if err != nil {
+		return err
+	}
+	days := 1
+	if v := ev.get("DTEND"); v != "" {
+		end, err := parseICalDate(v)
+		if err != nil {
+			return err
+		}
+		days = end.DaysSince(start)
+	} else if v := ev.get("DURATION"); v != "" {
+		p, err := ParsePeriod(v)
+		if err != nil {
+			return err
+		}
+		days = start.AddPeriod(p).DaysSince(start)
+	}
+	if v := ev.get("RRULE"); v != "" {
+		rule, err := ParseRRule(v)
+		if err != nil {
+			return err
+		}
+		r := icalRule{start: start, days: days, rule: rule}
+		for _, x := range ev.exdates {
+			if d, err := parseICalDate(x); err == nil {
+				r.exdates = append(r.exdates, d)
+			}
+		}
+		c.rules = append(c.rules, r)
+		return nil
+	}
+	for i := 0; i < days; i++ {
+		c.dates = append(c.dates, start.AddDays(i))
+	}
+	return nil
+}
+
+// parseICalDate parses the date of an iCalendar DATE or DATE-TIME value.
+func parseICalDate(s string) (Date, error) {
+	if len(s) > len(BasicDateLayout) && (s[8] == 'T' || s[8] == 't') {
+		s = s[:8]
+	}
+	t, err := time.Parse(BasicDateLayout, s)
+	if err != nil {
+		return Date{}, fmt.Errorf("invalid date %q", s)
+	}
+	return DateOf(t), nil
+}
+
+// IsHoliday reports whether d is covered by an event.
+func (c *ICalendarHolidays) IsHoliday(d Date) bool {
+	return ContainsDate(c.holidaysIn(d.Year), d)
+}
+
+// HolidaysIn returns the dates in the given year covered by an event, in
+// ascending order.
+func (c *ICalendarHolidays) HolidaysIn(year int) []Date {
+	return slices.Clone(c.holidaysIn(year))
+}
+
+// holidaysIn returns the holidays of year, computing them on first use.
+func (c *ICalendarHolidays) holidaysIn(year int) []Date {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if ds, ok := c.years[year]; ok {
+		return ds
+	}
+	y := Year(year)
+	ds := slices.Clone(HolidayList(c.dates).HolidaysIn(year))
+	for _, r := range c.rules {
+		for o := range r.rule.Dates(r.start) {
+			if o.After(y.LastDate()) {
+				break
+			}
+			if slices.Contains(r.exdates, o) {
+				continue
+			}
+			for i := 0; i < r.days; i++ {
+				if d := o.AddDays(i); y.Contains(d) {
+					ds = append(ds, d)
+				}
+			}
+		}
+	}
+	ds = DedupDates(ds)
+	c.years[year] = ds
+	return ds
+}
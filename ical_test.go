@@ -0,0 +1,108 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+const testICalendar = `BEGIN:VCALENDAR
+VERSION:2.0
+PRODID:-//Example//Holidays//EN
+BEGIN:VEVENT
+UID:new-year
+DTSTART;VALUE=DATE:20240101
+RRULE:FREQ=YEARLY
+SUMMARY:New Year's Day
+END:VEVENT
+BEGIN:VEVENT
+UID:christmas
+DTSTART;VALUE=DATE:20241225
+DTEND;VALUE=DATE:20241227
+SUMMARY:Christmas and
+ Boxing Day
+END:VEVENT
+BEGIN:VEVENT
+UID:memorial
+DTSTART;VALUE=DATE:20240527
+RRULE:FREQ=YEARLY;BYMONTH=5;BYDAY=-1MO
+EXDATE;VALUE=DATE:20250526
+END:VEVENT
+BEGIN:VEVENT
+UID:retreat
+DTSTART;VALUE=DATE:20240708
+DURATION:P3D
+END:VEVENT
+BEGIN:VEVENT
+UID:meeting
+DTSTART:20240710T090000Z
+DTEND:20240710T100000Z
+END:VEVENT
+BEGIN:VEVENT
+UID:cancelled
+DTSTART;VALUE=DATE:20240801
+STATUS:CANCELLED
+END:VEVENT
+END:VCALENDAR
+`
+
+func TestParseICalendar(t *testing.T) {
+	c, err := ParseICalendar(strings.NewReader(strings.ReplaceAll(testICalendar, "\n", "\r\n")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range []struct {
+		year int
+		want []Date
+	}{
+		{2024, []Date{{2024, 1, 1}, {2024, 5, 27}, {2024, 7, 8}, {2024, 7, 9}, {2024, 7, 10}, {2024, 12, 25}, {2024, 12, 26}}},
+		{2025, []Date{{2025, 1, 1}}},
+		{2026, []Date{{2026, 1, 1}, {2026, 5, 25}}},
+		{2023, nil},
+	} {
+		if got := c.HolidaysIn(test.year); !slices.Equal(got, test.want) {
+			t.Errorf("HolidaysIn(%d) = %v, want %v", test.year, got, test.want)
+		}
+	}
+	for _, test := range []struct {
+		d    Date
+		want bool
+	}{
+		{Date{2030, 1, 1}, true},
+		{Date{2024, 12, 27}, false},
+		{Date{2024, 8, 1}, false},
+		{Date{2024, 7, 11}, false},
+	} {
+		if got := c.IsHoliday(test.d); got != test.want {
+			t.Errorf("IsHoliday(%v) = %t, want %t", test.d, got, test.want)
+		}
+	}
+}
+
+func TestParseICalendarRejects(t *testing.T) {
+	for _, event := range []string{
+		"DTSTART;VALUE=DATE:2024-01-01",
+		"DTSTART;VALUE=DATE:20240101\nDTEND;VALUE=DATE:20241301",
+		"DTSTART;VALUE=DATE:20240101\nDURATION:1D",
+		"DTSTART;VALUE=DATE:20240101\nRRULE:FREQ=HOURLY",
+	} {
+		s := "BEGIN:VCALENDAR\nBEGIN:VEVENT\n" + event + "\nEND:VEVENT\nEND:VCALENDAR\n"
+		if _, err := ParseICalendar(strings.NewReader(s)); err == nil {
+			t.Errorf("ParseICalendar(%q) did not fail", s)
+		}
+	}
+}
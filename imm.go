@@ -0,0 +1,60 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import "time"
+
+// IMM dates are the quarterly settlement dates of the International
+// Monetary Market: the third Wednesday of March, June, September and
+// December, on which futures and many swaps mature.
+
+// IMMDate returns the third Wednesday of the given month. The month need
+// not be one of the quarterly IMM months.
+func IMMDate(year int, month time.Month) Date {
+	d, _ := NthWeekdayOfMonth(year, month, 3, time.Wednesday)
+	return d
+}
+
+// IsIMMDate reports whether d is the third Wednesday of March, June,
+// September or December.
+func IsIMMDate(d Date) bool {
+	return d.Month%3 == 0 && d == IMMDate(d.Year, d.Month)
+}
+
+// NextIMMDate returns the first IMM date after d.
+func NextIMMDate(d Date) Date {
+	month := d.Month + (3-d.Month%3)%3
+	if imm := IMMDate(d.Year, month); imm.After(d) {
+		return imm
+	}
+	q := Quarter{Year: d.Year, Quarter: int(month) / 3}.AddQuarters(1)
+	return IMMDate(q.Year, time.Month(3*q.Quarter))
+}
+
+// PreviousIMMDate returns the last IMM date before d.
+func PreviousIMMDate(d Date) Date {
+	month := d.Month - d.Month%3
+	if month == d.Month {
+		if imm := IMMDate(d.Year, month); imm.Before(d) {
+			return imm
+		}
+		month -= 3
+	}
+	year := d.Year
+	if month == 0 {
+		year, month = year-1, time.December
+	}
+	return IMMDate(year, month)
+}
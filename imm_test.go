@@ -0,0 +1,70 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"testing"
+	"time"
+)
+
+func TestIMMDate(t *testing.T) {
+	for _, test := range []struct {
+		year  int
+		month time.Month
+		want  Date
+	}{
+		{2024, time.March, Date{2024, 3, 20}},
+		{2024, time.June, Date{2024, 6, 19}},
+		{2024, time.September, Date{2024, 9, 18}},
+		{2024, time.December, Date{2024, 12, 18}},
+		{2025, time.March, Date{2025, 3, 19}},
+		{2025, time.June, Date{2025, 6, 18}},
+		{2026, time.September, Date{2026, 9, 16}},
+		{2023, time.February, Date{2023, 2, 15}},
+	} {
+		got := IMMDate(test.year, test.month)
+		if got != test.want {
+			t.Errorf("IMMDate(%d, %v) = %v, want %v", test.year, test.month, got, test.want)
+		}
+		if want := test.month%3 == 0; IsIMMDate(got) != want {
+			t.Errorf("IsIMMDate(%v) = %t, want %t", got, !want, want)
+		}
+		if IsIMMDate(got.AddDays(7)) || IsIMMDate(got.AddDays(-7)) {
+			t.Errorf("IsIMMDate of a week from %v = true, want false", got)
+		}
+	}
+}
+
+func TestNextPreviousIMMDate(t *testing.T) {
+	for _, test := range []struct {
+		d          Date
+		next, prev Date
+	}{
+		{Date{2024, 1, 1}, Date{2024, 3, 20}, Date{2023, 12, 20}},
+		{Date{2024, 3, 19}, Date{2024, 3, 20}, Date{2023, 12, 20}},
+		{Date{2024, 3, 20}, Date{2024, 6, 19}, Date{2023, 12, 20}},
+		{Date{2024, 3, 21}, Date{2024, 6, 19}, Date{2024, 3, 20}},
+		{Date{2024, 6, 30}, Date{2024, 9, 18}, Date{2024, 6, 19}},
+		{Date{2024, 12, 18}, Date{2025, 3, 19}, Date{2024, 9, 18}},
+		{Date{2024, 12, 31}, Date{2025, 3, 19}, Date{2024, 12, 18}},
+	} {
+		if got := NextIMMDate(test.d); got != test.next {
+			t.Errorf("NextIMMDate(%v) = %v, want %v", test.d, got, test.next)
+		}
+		if got := PreviousIMMDate(test.d); got != test.prev {
+			t.Errorf("PreviousIMMDate(%v) = %v, want %v", test.d, got, test.prev)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// An ISOWeek represents a week of an ISO 8601 week-numbering year. Weeks
+// start on Monday, and week 1 is the week containing the year's first
+// Thursday, so the first and last weeks of a year may include days of the
+// adjacent calendar years.
+type ISOWeek struct {
+	Year int // The ISO 8601 week-numbering year.
+	Week int // The week of the year; range [1-53]
+}
+
+// ISOWeekOf returns the ISO 8601 week in which d occurs.
+func ISOWeekOf(d Date) ISOWeek {
+	y, w := d.ISOWeek()
+	return ISOWeek{Year: y, Week: w}
+}
+
+// FromISOWeek returns the date of the given weekday in the given week of
+// the ISO 8601 week-numbering year. It is the inverse of Date.ISOWeek. Weeks
+// start on Monday, so Sunday is the last day of the week. A week outside
+// the year is normalized: week 0 is the last week of the previous year.
+func FromISOWeek(year, week int, weekday time.Weekday) Date {
+	// Week 1 is the week containing January 4.
+	jan4 := daysFromCivil(year, time.January, 4)
+	week1 := jan4 - floorMod(jan4+3, 7)
+	return DateFromEpochDay(week1 + 7*(week-1) + (int(weekday)+6)%7)
+}
+
+// ParseISOWeek parses a string in the ISO 8601 format YYYY-Www, as in
+// "2024-W05", and returns the week it represents.
+func ParseISOWeek(s string) (ISOWeek, error) {
+	if len(s) != 8 || s[4] != '-' || s[5] != 'W' || !isDigits(s[:4]) || !isDigits(s[6:]) {
+		return ISOWeek{}, fmt.Errorf("civil: cannot parse %q as an ISO week", s)
+	}
+	var w ISOWeek
+	w.Year, _ = strconv.Atoi(s[:4])
+	w.Week, _ = strconv.Atoi(s[6:])
+	if !w.IsValid() {
+		return ISOWeek{}, fmt.Errorf("civil: week out of range in %q", s)
+	}
+	return w, nil
+}
+
+// String returns the week in the ISO 8601 format YYYY-Www.
+func (w ISOWeek) String() string {
+	return fmt.Sprintf("%04d-W%02d", w.Year, w.Week)
+}
+
+// IsValid reports whether the week occurs in its year. Years have 52 or 53
+// weeks.
+func (w ISOWeek) IsValid() bool {
+	return w.Week >= 1 && ISOWeekOf(w.Monday()) == w
+}
+
+// Monday returns the first day of the week.
+func (w ISOWeek) Monday() Date {
+	return FromISOWeek(w.Year, w.Week, time.Monday)
+}
+
+// Sunday returns the last day of the week.
+func (w ISOWeek) Sunday() Date {
+	return w.Monday().AddDays(6)
+}
+
+// Contains reports whether d falls in the week.
+func (w ISOWeek) Contains(d Date) bool {
+	return ISOWeekOf(d) == w
+}
+
+// AddWeeks returns the week that is n weeks after w. n may be negative.
+func (w ISOWeek) AddWeeks(n int) ISOWeek {
+	return ISOWeekOf(w.Monday().AddDays(7 * n))
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// The output is the result of w.String().
+func (w ISOWeek) MarshalText() ([]byte, error) {
+	return []byte(w.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// The week is expected to be a string in a format accepted by ParseISOWeek.
+func (w *ISOWeek) UnmarshalText(data []byte) error {
+	var err error
+	*w, err = ParseISOWeek(string(data))
+	return err
+}
@@ -0,0 +1,128 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"testing"
+	"time"
+)
+
+func TestISOWeek(t *testing.T) {
+	for _, test := range []struct {
+		w              ISOWeek
+		str            string
+		monday, sunday Date
+	}{
+		{ISOWeek{2024, 1}, "2024-W01", Date{2024, 1, 1}, Date{2024, 1, 7}},
+		{ISOWeek{2024, 5}, "2024-W05", Date{2024, 1, 29}, Date{2024, 2, 4}},
+		{ISOWeek{2025, 1}, "2025-W01", Date{2024, 12, 30}, Date{2025, 1, 5}},
+		{ISOWeek{2020, 53}, "2020-W53", Date{2020, 12, 28}, Date{2021, 1, 3}},
+		{ISOWeek{2021, 1}, "2021-W01", Date{2021, 1, 4}, Date{2021, 1, 10}},
+		{ISOWeek{2026, 53}, "2026-W53", Date{2026, 12, 28}, Date{2027, 1, 3}},
+	} {
+		if got := test.w.String(); got != test.str {
+			t.Errorf("%#v.String() = %q, want %q", test.w, got, test.str)
+		}
+		if got, err := ParseISOWeek(test.str); err != nil || got != test.w {
+			t.Errorf("ParseISOWeek(%q) = %v, %v, want %v", test.str, got, err, test.w)
+		}
+		if got := test.w.Monday(); got != test.monday {
+			t.Errorf("%v.Monday() = %v, want %v", test.w, got, test.monday)
+		}
+		if got := test.w.Sunday(); got != test.sunday {
+			t.Errorf("%v.Sunday() = %v, want %v", test.w, got, test.sunday)
+		}
+		for _, d := range []Date{test.monday, test.sunday} {
+			if got := ISOWeekOf(d); got != test.w {
+				t.Errorf("ISOWeekOf(%v) = %v, want %v", d, got, test.w)
+			}
+			if !test.w.Contains(d) {
+				t.Errorf("%v.Contains(%v) = false", test.w, d)
+			}
+		}
+		if test.w.Contains(test.sunday.AddDays(1)) || test.w.Contains(test.monday.AddDays(-1)) {
+			t.Errorf("%v contains a day outside %v–%v", test.w, test.monday, test.sunday)
+		}
+	}
+}
+
+func TestParseISOWeekRejects(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"2024-W00",
+		"2024-W53",
+		"2020-W54",
+		"2024W05",
+		"2024-w05",
+		"2024-W5",
+		"2024-W005",
+		"2024-05",
+		"+024-W05",
+	} {
+		if w, err := ParseISOWeek(s); err == nil {
+			t.Errorf("ParseISOWeek(%q) = %v, want error", s, w)
+		}
+	}
+}
+
+func TestISOWeekAddWeeks(t *testing.T) {
+	for _, test := range []struct {
+		w    ISOWeek
+		n    int
+		want ISOWeek
+	}{
+		{ISOWeek{2024, 1}, 0, ISOWeek{2024, 1}},
+		{ISOWeek{2024, 52}, 1, ISOWeek{2025, 1}},
+		{ISOWeek{2020, 52}, 1, ISOWeek{2020, 53}},
+		{ISOWeek{2020, 53}, 1, ISOWeek{2021, 1}},
+		{ISOWeek{2021, 1}, -1, ISOWeek{2020, 53}},
+		{ISOWeek{2024, 10}, -62, ISOWeek{2022, 52}},
+	} {
+		if got := test.w.AddWeeks(test.n); got != test.want {
+			t.Errorf("%v.AddWeeks(%d) = %v, want %v", test.w, test.n, got, test.want)
+		}
+	}
+}
+
+func TestFromISOWeek(t *testing.T) {
+	for _, test := range []struct {
+		year, week int
+		weekday    time.Weekday
+		want       Date
+	}{
+		{2024, 1, time.Monday, Date{2024, 1, 1}},
+		{2024, 1, time.Sunday, Date{2024, 1, 7}},
+		{2020, 53, time.Friday, Date{2021, 1, 1}},
+		{2025, 1, time.Monday, Date{2024, 12, 30}},
+		// Weeks outside the year are normalized.
+		{2024, 0, time.Monday, Date{2023, 12, 25}},
+		{2024, 53, time.Monday, Date{2024, 12, 30}},
+	} {
+		if got := FromISOWeek(test.year, test.week, test.weekday); got != test.want {
+			t.Errorf("FromISOWeek(%d, %d, %v) = %v, want %v", test.year, test.week, test.weekday, got, test.want)
+		}
+	}
+	// Check ISOWeek and its inverse against the time package.
+	for d := (Date{1990, 1, 1}); d.Before(Date{2031, 1, 1}); d = d.AddDays(1) {
+		year, week := d.ISOWeek()
+		wantYear, wantWeek := d.In(time.UTC).ISOWeek()
+		if year != wantYear || week != wantWeek {
+			t.Fatalf("%v.ISOWeek() = %d, %d, want %d, %d", d, year, week, wantYear, wantWeek)
+		}
+		if got := FromISOWeek(year, week, d.Weekday()); got != d {
+			t.Fatalf("FromISOWeek(%d, %d, %v) = %v, want %v", year, week, d.Weekday(), got, d)
+		}
+	}
+}
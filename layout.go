@@ -0,0 +1,85 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Layouts, in the form used by time.Parse, of the formats the package
+// understands. The fractional seconds of a time are optional when parsing
+// and omitted when formatting if they are zero.
+const (
+	DateLayout          = "2006-01-02"                  // RFC3339 full-date
+	TimeLayout          = "15:04:05.999999999"          // RFC3339 partial-time
+	DateTimeLayout      = DateLayout + "T" + TimeLayout // RFC3339 date-time without offset
+	BasicDateLayout     = "20060102"                    // ISO 8601 basic format
+	BasicTimeLayout     = "150405.999999999"            // ISO 8601 basic format
+	BasicDateTimeLayout = BasicDateLayout + "T" + BasicTimeLayout
+)
+
+var (
+	layoutsMu sync.RWMutex
+	layouts   = []string{
+		DateTimeLayout,
+		DateLayout + "t" + TimeLayout,
+		DateLayout + " " + TimeLayout,
+		DateLayout,
+		BasicDateTimeLayout,
+		BasicDateLayout,
+	}
+)
+
+// RegisterLayout adds a layout, in the form used by time.Parse, to the
+// layouts tried by ParseAny. Layouts are tried in the order in which they
+// were registered, after those registered by the package. A layout that is
+// already registered is not added again.
+func RegisterLayout(layout string) {
+	layoutsMu.Lock()
+	defer layoutsMu.Unlock()
+	for _, l := range layouts {
+		if l == layout {
+			return
+		}
+	}
+	layouts = append(layouts, layout)
+}
+
+// Layouts returns the layouts tried by ParseAny, in order.
+func Layouts() []string {
+	layoutsMu.RLock()
+	defer layoutsMu.RUnlock()
+	return append([]string(nil), layouts...)
+}
+
+// ParseAny parses s with each of the registered layouts in turn and returns
+// the DateTime of the first that succeeds. By default these are the
+// DateTimeLayout and DateLayout formats, the same with a lower-case 't' or a
+// space separating the date and time, and their ISO 8601 basic forms. For a
+// layout without a time, the time of the result is midnight.
+//
+// Any UTC offset or time zone parsed by a layout is ignored; the result is
+// the wall-clock value of s.
+func ParseAny(s string) (DateTime, error) {
+	s = normalizeFraction(s)
+	for _, l := range Layouts() {
+		if t, err := time.Parse(l, s); err == nil {
+			return DateTimeOf(t), nil
+		}
+	}
+	return DateTime{}, fmt.Errorf("civil: cannot parse %q with any registered layout", s)
+}
@@ -0,0 +1,66 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import "testing"
+
+func TestParseAny(t *testing.T) {
+	for _, test := range []struct {
+		s    string
+		want DateTime
+	}{
+		{"2024-03-05T09:07:03", DateTime{Date{2024, 3, 5}, Time{9, 7, 3, 0}}},
+		{"2024-03-05t09:07:03.5", DateTime{Date{2024, 3, 5}, Time{9, 7, 3, 500000000}}},
+		{"2024-03-05 09:07:03,25", DateTime{Date{2024, 3, 5}, Time{9, 7, 3, 250000000}}},
+		{"2024-03-05", DateTime{Date{2024, 3, 5}, Time{}}},
+		{"20240305T090703", DateTime{Date{2024, 3, 5}, Time{9, 7, 3, 0}}},
+		{"20240305T090703.123", DateTime{Date{2024, 3, 5}, Time{9, 7, 3, 123000000}}},
+		{"20240305", DateTime{Date{2024, 3, 5}, Time{}}},
+	} {
+		if got, err := ParseAny(test.s); err != nil || got != test.want {
+			t.Errorf("ParseAny(%q) = %v, %v, want %v", test.s, got, err, test.want)
+		}
+	}
+	for _, s := range []string{"", "2024-03-05X09:07:03", "2024-02-30", "05/03/2024", "2024-03-05T25:00:00"} {
+		if got, err := ParseAny(s); err == nil {
+			t.Errorf("ParseAny(%q) = %v, want error", s, got)
+		}
+	}
+}
+
+func TestRegisterLayout(t *testing.T) {
+	saved := Layouts()
+	defer func() { layouts = saved }()
+
+	const layout = "02/01/2006 15:04 MST"
+	if _, err := ParseAny("05/03/2024 09:07 CET"); err == nil {
+		t.Fatalf("ParseAny succeeded before %q was registered", layout)
+	}
+	RegisterLayout(layout)
+	RegisterLayout(layout)
+	if got, want := len(Layouts()), len(saved)+1; got != want {
+		t.Errorf("len(Layouts()) = %d after registering a layout twice, want %d", got, want)
+	}
+	// The zone is ignored; the result is the wall-clock value.
+	want := DateTime{Date{2024, 3, 5}, Time{9, 7, 0, 0}}
+	if got, err := ParseAny("05/03/2024 09:07 CET"); err != nil || got != want {
+		t.Errorf("ParseAny = %v, %v, want %v", got, err, want)
+	}
+	// A layout the package registers is not added again.
+	RegisterLayout(DateLayout)
+	if got, want := len(Layouts()), len(saved)+1; got != want {
+		t.Errorf("len(Layouts()) = %d after registering DateLayout, want %d", got, want)
+	}
+}
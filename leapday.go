@@ -0,0 +1,107 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"fmt"
+	"time"
+)
+
+// A LeapDayPolicy specifies when an anniversary of February 29 is observed
+// in a year that is not a leap year. Jurisdictions and business rules differ
+// on the answer, so functions that compute anniversaries take an explicit
+// policy.
+type LeapDayPolicy int
+
+const (
+	// ObserveFeb28 observes the anniversary on February 28.
+	ObserveFeb28 LeapDayPolicy = iota
+	// ObserveMar1 observes the anniversary on March 1, as time.Time.AddDate
+	// does.
+	ObserveMar1
+	// SkipLeapDay observes no anniversary in the year.
+	SkipLeapDay
+)
+
+// String returns the name of the policy, such as "ObserveFeb28".
+func (p LeapDayPolicy) String() string {
+	switch p {
+	case ObserveFeb28:
+		return "ObserveFeb28"
+	case ObserveMar1:
+		return "ObserveMar1"
+	case SkipLeapDay:
+		return "SkipLeapDay"
+	}
+	return fmt.Sprintf("LeapDayPolicy(%d)", int(p))
+}
+
+// resolve returns the date on which the given month and day are observed in
+// year. It reports false if, under SkipLeapDay, there is no such date.
+func (p LeapDayPolicy) resolve(year int, month time.Month, day int) (Date, bool) {
+	d := Date{Year: year, Month: month, Day: day}
+	if month != time.February || day != 29 || Year(year).IsLeap() {
+		return d, true
+	}
+	switch p {
+	case ObserveMar1:
+		return Date{Year: year, Month: time.March, Day: 1}, true
+	case SkipLeapDay:
+		return Date{}, false
+	}
+	return Date{Year: year, Month: time.February, Day: 28}, true
+}
+
+// AddYearsWithPolicy returns the date that is n years after d, observing an
+// anniversary of February 29 according to p. It reports false if p is
+// SkipLeapDay and the resulting year has no February 29.
+func (d Date) AddYearsWithPolicy(n int, p LeapDayPolicy) (Date, bool) {
+	return p.resolve(d.Year+n, d.Month, d.Day)
+}
+
+// Anniversary returns the date in the year inYear with the same month and
+// day as of, such as the renewal date of a contract signed on of. An
+// anniversary of February 29 is observed according to policy; Anniversary
+// reports false if policy is SkipLeapDay and inYear has no February 29.
+func Anniversary(of Date, inYear int, policy LeapDayPolicy) (Date, bool) {
+	return policy.resolve(inYear, of.Month, of.Day)
+}
+
+// AgeAt returns the number of whole years from d, such as a date of birth,
+// to on: the age on that date. A birthday of February 29 is observed on
+// February 28 in years that are not leap years; use AgeAtWithPolicy to
+// choose otherwise. If on is before d, the result is the negation of
+// on.AgeAt(d). For the age in years, months and days, use on.Sub(d).
+func (d Date) AgeAt(on Date) int {
+	return d.AgeAtWithPolicy(on, ObserveFeb28)
+}
+
+// AgeAtWithPolicy is like AgeAt, but observes a birthday of February 29
+// according to p. Under SkipLeapDay, which observes no birthday in a year
+// without February 29, the age still increases in such a year, on March 1.
+func (d Date) AgeAtWithPolicy(on Date, p LeapDayPolicy) int {
+	if on.Before(d) {
+		return -on.AgeAtWithPolicy(d, p)
+	}
+	if p == SkipLeapDay {
+		p = ObserveMar1
+	}
+	years := on.Year - d.Year
+	birthday, _ := p.resolve(on.Year, d.Month, d.Day)
+	if on.Before(birthday) {
+		years--
+	}
+	return years
+}
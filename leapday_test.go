@@ -0,0 +1,150 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import "testing"
+
+func TestAddYearsWithPolicy(t *testing.T) {
+	leap := Date{2024, 2, 29}
+	for _, test := range []struct {
+		d      Date
+		n      int
+		p      LeapDayPolicy
+		want   Date
+		wantOK bool
+	}{
+		{leap, 1, ObserveFeb28, Date{2025, 2, 28}, true},
+		{leap, 1, ObserveMar1, Date{2025, 3, 1}, true},
+		{leap, 1, SkipLeapDay, Date{}, false},
+		{leap, 4, SkipLeapDay, Date{2028, 2, 29}, true},
+		{leap, -1, ObserveFeb28, Date{2023, 2, 28}, true},
+		{leap, 76, ObserveMar1, Date{2100, 3, 1}, true},
+		{Date{2024, 2, 28}, 1, SkipLeapDay, Date{2025, 2, 28}, true},
+		{Date{2024, 3, 1}, 1, ObserveFeb28, Date{2025, 3, 1}, true},
+	} {
+		got, ok := test.d.AddYearsWithPolicy(test.n, test.p)
+		if got != test.want || ok != test.wantOK {
+			t.Errorf("%v.AddYearsWithPolicy(%d, %v) = %v, %t, want %v, %t", test.d, test.n, test.p, got, ok, test.want, test.wantOK)
+		}
+	}
+}
+
+func TestAddYears(t *testing.T) {
+	for _, test := range []struct {
+		d    Date
+		n    int
+		want Date
+	}{
+		{Date{2024, 2, 29}, 1, Date{2025, 3, 1}},
+		{Date{2024, 2, 29}, 4, Date{2028, 2, 29}},
+		{Date{2024, 2, 29}, -4, Date{2020, 2, 29}},
+		{Date{2024, 12, 31}, -1, Date{2023, 12, 31}},
+	} {
+		if got := test.d.AddYears(test.n); got != test.want {
+			t.Errorf("%v.AddYears(%d) = %v, want %v", test.d, test.n, got, test.want)
+		}
+		// AddYears agrees with AddYearsWithPolicy under ObserveMar1.
+		if got, _ := test.d.AddYearsWithPolicy(test.n, ObserveMar1); got != test.want {
+			t.Errorf("%v.AddYearsWithPolicy(%d, ObserveMar1) = %v, want %v", test.d, test.n, got, test.want)
+		}
+	}
+}
+
+func TestLeapDayPolicyString(t *testing.T) {
+	for p, want := range map[LeapDayPolicy]string{
+		ObserveFeb28:     "ObserveFeb28",
+		ObserveMar1:      "ObserveMar1",
+		SkipLeapDay:      "SkipLeapDay",
+		LeapDayPolicy(9): "LeapDayPolicy(9)",
+	} {
+		if got := p.String(); got != want {
+			t.Errorf("LeapDayPolicy(%d).String() = %q, want %q", int(p), got, want)
+		}
+	}
+}
+
+func TestAgeAt(t *testing.T) {
+	born := Date{1990, 7, 14}
+	for _, test := range []struct {
+		on   Date
+		want int
+	}{
+		{Date{1990, 7, 14}, 0},
+		{Date{1991, 7, 13}, 0},
+		{Date{1991, 7, 14}, 1},
+		{Date{2024, 7, 13}, 33},
+		{Date{2024, 7, 14}, 34},
+		{Date{1989, 7, 15}, 0},
+		{Date{1989, 7, 14}, -1},
+		{Date{1980, 1, 1}, -10},
+	} {
+		if got := born.AgeAt(test.on); got != test.want {
+			t.Errorf("%v.AgeAt(%v) = %d, want %d", born, test.on, got, test.want)
+		}
+	}
+}
+
+func TestAgeAtLeapDay(t *testing.T) {
+	born := Date{2000, 2, 29}
+	for _, test := range []struct {
+		on                    Date
+		feb28, mar1, skipLeap int
+	}{
+		{Date{2001, 2, 27}, 0, 0, 0},
+		{Date{2001, 2, 28}, 1, 0, 0},
+		{Date{2001, 3, 1}, 1, 1, 1},
+		{Date{2004, 2, 28}, 3, 3, 3},
+		{Date{2004, 2, 29}, 4, 4, 4},
+		{Date{2100, 2, 28}, 100, 99, 99},
+		{Date{2100, 3, 1}, 100, 100, 100},
+	} {
+		for _, p := range []struct {
+			p    LeapDayPolicy
+			want int
+		}{{ObserveFeb28, test.feb28}, {ObserveMar1, test.mar1}, {SkipLeapDay, test.skipLeap}} {
+			if got := born.AgeAtWithPolicy(test.on, p.p); got != p.want {
+				t.Errorf("%v.AgeAtWithPolicy(%v, %v) = %d, want %d", born, test.on, p.p, got, p.want)
+			}
+		}
+		if got := born.AgeAt(test.on); got != test.feb28 {
+			t.Errorf("%v.AgeAt(%v) = %d, want %d", born, test.on, got, test.feb28)
+		}
+	}
+}
+
+func TestAnniversary(t *testing.T) {
+	leap := Date{2024, 2, 29}
+	for _, test := range []struct {
+		of     Date
+		year   int
+		p      LeapDayPolicy
+		want   Date
+		wantOK bool
+	}{
+		{Date{2020, 6, 15}, 2025, SkipLeapDay, Date{2025, 6, 15}, true},
+		{Date{2020, 6, 15}, 2019, ObserveFeb28, Date{2019, 6, 15}, true},
+		{leap, 2025, ObserveFeb28, Date{2025, 2, 28}, true},
+		{leap, 2025, ObserveMar1, Date{2025, 3, 1}, true},
+		{leap, 2025, SkipLeapDay, Date{}, false},
+		{leap, 2028, SkipLeapDay, Date{2028, 2, 29}, true},
+		{leap, 2100, ObserveMar1, Date{2100, 3, 1}, true},
+		{leap, 2000, ObserveFeb28, Date{2000, 2, 29}, true},
+	} {
+		got, ok := Anniversary(test.of, test.year, test.p)
+		if got != test.want || ok != test.wantOK {
+			t.Errorf("Anniversary(%v, %d, %v) = %v, %t, want %v, %t", test.of, test.year, test.p, got, ok, test.want, test.wantOK)
+		}
+	}
+}
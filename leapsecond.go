@@ -0,0 +1,78 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"fmt"
+	"strings"
+)
+
+// A LeapSecondPolicy specifies how a Parser or Codec treats a time whose
+// seconds are 60, such as the leap second 23:59:60 found in NTP logs and
+// astronomical data. The policy accepts 60 seconds at the end of any
+// minute, since a leap second falls at 23:59:60 UTC and so at other times
+// in civil time elsewhere.
+type LeapSecondPolicy int
+
+const (
+	// RejectLeapSecond reports an error for a leap second, as ParseTime
+	// does.
+	RejectLeapSecond LeapSecondPolicy = iota
+	// ClampLeapSecond replaces a leap second with the last valid instant of
+	// its minute, so that 23:59:60.5 becomes 23:59:59.999999999.
+	ClampLeapSecond
+	// PreserveLeapSecond keeps a leap second as a Time whose Second field
+	// is 60. Such a Time is not valid, and a DateTime containing it
+	// normalizes to the start of the following minute.
+	PreserveLeapSecond
+)
+
+// String returns the name of the policy, such as "ClampLeapSecond".
+func (p LeapSecondPolicy) String() string {
+	switch p {
+	case RejectLeapSecond:
+		return "RejectLeapSecond"
+	case ClampLeapSecond:
+		return "ClampLeapSecond"
+	case PreserveLeapSecond:
+		return "PreserveLeapSecond"
+	}
+	return fmt.Sprintf("LeapSecondPolicy(%d)", int(p))
+}
+
+// apply returns the leap second that was parsed as t, whose seconds were
+// read as 59, according to p.
+func (p LeapSecondPolicy) apply(t Time) Time {
+	if p == PreserveLeapSecond {
+		t.Second = 60
+	} else {
+		t.Nanosecond = 999999999
+	}
+	return t
+}
+
+// cutLeapSecond reports whether the seconds of s, a time or a datetime, are
+// 60, and if so returns s with them replaced by 59.
+func cutLeapSecond(s string) (string, bool) {
+	i := strings.LastIndexByte(s, ':')
+	if i < 0 || !strings.HasPrefix(s[i+1:], "60") {
+		return s, false
+	}
+	rest := s[i+3:]
+	if rest != "" && rest[0] != '.' && rest[0] != ',' {
+		return s, false
+	}
+	return s[:i+1] + "59" + rest, true
+}
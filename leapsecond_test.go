@@ -0,0 +1,83 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import "testing"
+
+func TestParserLeapSeconds(t *testing.T) {
+	for _, test := range []struct {
+		p    LeapSecondPolicy
+		s    string
+		want Time
+		ok   bool
+	}{
+		{RejectLeapSecond, "23:59:60", Time{}, false},
+		{ClampLeapSecond, "23:59:60", Time{23, 59, 59, 999999999}, true},
+		{ClampLeapSecond, "23:59:60.5", Time{23, 59, 59, 999999999}, true},
+		{ClampLeapSecond, "08:29:60,25", Time{8, 29, 59, 999999999}, true},
+		{PreserveLeapSecond, "23:59:60", Time{23, 59, 60, 0}, true},
+		{PreserveLeapSecond, "23:59:60.5", Time{23, 59, 60, 500000000}, true},
+		{ClampLeapSecond, "23:59:61", Time{}, false},
+		{ClampLeapSecond, "23:60:00", Time{}, false},
+		{ClampLeapSecond, "23:59:59", Time{23, 59, 59, 0}, true},
+	} {
+		got, err := Parser{LeapSeconds: test.p}.ParseTime(test.s)
+		if (err == nil) != test.ok || got != test.want {
+			t.Errorf("%v: ParseTime(%q) = %v, %v, want %v, ok %t", test.p, test.s, got, err, test.want, test.ok)
+		}
+	}
+	dt, err := Parser{LeapSeconds: PreserveLeapSecond}.ParseDateTime("2016-12-31T23:59:60")
+	if err != nil || dt != (DateTime{Date{2016, 12, 31}, Time{23, 59, 60, 0}}) {
+		t.Fatalf("ParseDateTime(2016-12-31T23:59:60) = %v, %v", dt, err)
+	}
+	if dt.IsValid() {
+		t.Errorf("%v.IsValid() = true, want false", dt)
+	}
+	if got, want := dt.Normalize(), (DateTime{Date: Date{2017, 1, 1}}); got != want {
+		t.Errorf("%v.Normalize() = %v, want %v", dt, got, want)
+	}
+}
+
+func TestCodecLeapSeconds(t *testing.T) {
+	c := Codec{LeapSeconds: ClampLeapSecond, Offsets: StripOffset}
+	want := DateTime{Date{2016, 12, 31}, Time{23, 59, 59, 999999999}}
+	if got, err := c.ParseDateTime("2016-12-31 23:59:60"); err != nil || got != want {
+		t.Errorf("ParseDateTime(2016-12-31 23:59:60) = %v, %v, want %v", got, err, want)
+	}
+	var dt DateTime
+	if err := c.ScanDateTime(&dt).Scan("2016-12-31 23:59:60+00"); err != nil || dt != want {
+		t.Errorf("ScanDateTime(2016-12-31 23:59:60+00) = %v, %v, want %v", dt, err, want)
+	}
+	var tm Time
+	if err := c.ScanTime(&tm).Scan([]byte("23:59:60")); err != nil || tm != want.Time {
+		t.Errorf("ScanTime(23:59:60) = %v, %v, want %v", tm, err, want.Time)
+	}
+	if _, err := (Codec{}).ParseDateTime("2016-12-31 23:59:60"); err == nil {
+		t.Error("Codec{}.ParseDateTime(2016-12-31 23:59:60) did not fail")
+	}
+}
+
+func TestLeapSecondPolicyString(t *testing.T) {
+	for p, want := range map[LeapSecondPolicy]string{
+		RejectLeapSecond:    "RejectLeapSecond",
+		ClampLeapSecond:     "ClampLeapSecond",
+		PreserveLeapSecond:  "PreserveLeapSecond",
+		LeapSecondPolicy(7): "LeapSecondPolicy(7)",
+	} {
+		if got := p.String(); got != want {
+			t.Errorf("LeapSecondPolicy(%d).String() = %q, want %q", int(p), got, want)
+		}
+	}
+}
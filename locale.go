@@ -0,0 +1,194 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// MonthNames lists the accepted names of each month in a language, from
+// January to December. Names are matched without regard to case, and
+// typically include the full name and any common abbreviations. A trailing
+// period on an abbreviation is ignored and should not be included.
+type MonthNames [12][]string
+
+var (
+	localesMu sync.RWMutex
+	// localeOrder is the order in which languages are tried when the caller
+	// does not name any.
+	localeOrder = []string{"en", "fr", "de", "es", "it", "pt", "nl"}
+	locales     = map[string]MonthNames{
+		"en": {
+			{"january", "jan"}, {"february", "feb"}, {"march", "mar"},
+			{"april", "apr"}, {"may"}, {"june", "jun"},
+			{"july", "jul"}, {"august", "aug"}, {"september", "sep", "sept"},
+			{"october", "oct"}, {"november", "nov"}, {"december", "dec"},
+		},
+		"fr": {
+			{"janvier", "janv"}, {"février", "févr", "fevrier", "fevr"}, {"mars"},
+			{"avril", "avr"}, {"mai"}, {"juin"},
+			{"juillet", "juil"}, {"août", "aout"}, {"septembre", "sept"},
+			{"octobre", "oct"}, {"novembre", "nov"}, {"décembre", "déc", "decembre", "dec"},
+		},
+		"de": {
+			{"januar", "jan", "jänner", "jän"}, {"februar", "feb"}, {"märz", "mär", "maerz", "mrz"},
+			{"april", "apr"}, {"mai"}, {"juni", "jun"},
+			{"juli", "jul"}, {"august", "aug"}, {"september", "sep", "sept"},
+			{"oktober", "okt"}, {"november", "nov"}, {"dezember", "dez"},
+		},
+		"es": {
+			{"enero", "ene"}, {"febrero", "feb"}, {"marzo", "mar"},
+			{"abril", "abr"}, {"mayo", "may"}, {"junio", "jun"},
+			{"julio", "jul"}, {"agosto", "ago"}, {"septiembre", "setiembre", "sep", "sept", "set"},
+			{"octubre", "oct"}, {"noviembre", "nov"}, {"diciembre", "dic"},
+		},
+		"it": {
+			{"gennaio", "gen"}, {"febbraio", "feb"}, {"marzo", "mar"},
+			{"aprile", "apr"}, {"maggio", "mag"}, {"giugno", "giu"},
+			{"luglio", "lug"}, {"agosto", "ago"}, {"settembre", "set"},
+			{"ottobre", "ott"}, {"novembre", "nov"}, {"dicembre", "dic"},
+		},
+		"pt": {
+			{"janeiro", "jan"}, {"fevereiro", "fev"}, {"março", "marco", "mar"},
+			{"abril", "abr"}, {"maio", "mai"}, {"junho", "jun"},
+			{"julho", "jul"}, {"agosto", "ago"}, {"setembro", "set"},
+			{"outubro", "out"}, {"novembro", "nov"}, {"dezembro", "dez"},
+		},
+		"nl": {
+			{"januari", "jan"}, {"februari", "feb"}, {"maart", "mrt"},
+			{"april", "apr"}, {"mei"}, {"juni", "jun"},
+			{"juli", "jul"}, {"augustus", "aug"}, {"september", "sep", "sept"},
+			{"oktober", "okt"}, {"november", "nov"}, {"december", "dec"},
+		},
+	}
+)
+
+// RegisterMonthNames makes the month names of a language available to
+// ParseLocalizedDate, replacing any names already registered for lang.
+// The package provides names for "en", "fr", "de", "es", "it", "pt" and "nl".
+func RegisterMonthNames(lang string, names MonthNames) {
+	localesMu.Lock()
+	defer localesMu.Unlock()
+	if _, ok := locales[lang]; !ok {
+		localeOrder = append(localeOrder, lang)
+	}
+	var lower MonthNames
+	for i := range names {
+		for _, n := range names[i] {
+			lower[i] = append(lower[i], strings.ToLower(n))
+		}
+	}
+	locales[lang] = lower
+}
+
+// ParseLocalizedDate parses a date written with the name of the month, such
+// as "29 Feb 2020", "1er février 2020", "3. März 2021", "1 de enero de 2020",
+// "March 3rd, 2024" or "the 3rd of March 2024". The day and year are decimal
+// numbers, the day optionally followed by an ordinal suffix, and the year
+// must have four digits.
+//
+// The month name is looked up in the month names of the given languages, in
+// order, or in those of every registered language if none are given.
+func ParseLocalizedDate(s string, langs ...string) (Date, error) {
+	fields := strings.FieldsFunc(s, func(r rune) bool { return r == ' ' || r == ',' })
+	var day, year, name string
+	for _, f := range fields {
+		switch lf := strings.ToLower(f); {
+		case lf == "de" || lf == "del" || lf == "of" || lf == "the":
+			// Spanish and Portuguese "1 de enero de 2020", and English
+			// "the 3rd of March 2024".
+		case len(f) == 4 && isDigits(f):
+			if year != "" {
+				return Date{}, fmt.Errorf("civil: cannot parse %q as a date", s)
+			}
+			year = f
+		case f[0] >= '0' && f[0] <= '9':
+			if day != "" {
+				return Date{}, fmt.Errorf("civil: cannot parse %q as a date", s)
+			}
+			day = trimDaySuffix(lf)
+		default:
+			if name != "" {
+				return Date{}, fmt.Errorf("civil: cannot parse %q as a date", s)
+			}
+			name = strings.TrimSuffix(lf, ".")
+		}
+	}
+	if day == "" || year == "" || name == "" || len(day) > 2 || !isDigits(day) {
+		return Date{}, fmt.Errorf("civil: cannot parse %q as a date", s)
+	}
+	month, ok := lookupMonth(name, langs)
+	if !ok {
+		return Date{}, fmt.Errorf("civil: unknown month name %q", name)
+	}
+	var d Date
+	d.Year, _ = strconv.Atoi(year)
+	d.Month = month
+	d.Day, _ = strconv.Atoi(day)
+	if !d.IsValid() {
+		return Date{}, fmt.Errorf("civil: invalid date %q", s)
+	}
+	return d, nil
+}
+
+// trimDaySuffix removes a trailing period or ordinal suffix, such as the
+// "er" of French "1er" or the "rd" of English "3rd", from a day of the month.
+func trimDaySuffix(s string) string {
+	s = strings.TrimSuffix(s, ".")
+	for _, suffix := range []string{"er", "st", "nd", "rd", "th"} {
+		if strings.HasSuffix(s, suffix) {
+			return s[:len(s)-len(suffix)]
+		}
+	}
+	return s
+}
+
+// lookupMonth returns the month with the given lower-case name in the first
+// of langs that has one, or in any registered language if langs is empty.
+func lookupMonth(name string, langs []string) (time.Month, bool) {
+	localesMu.RLock()
+	defer localesMu.RUnlock()
+	if len(langs) == 0 {
+		langs = localeOrder
+	}
+	for _, lang := range langs {
+		names, ok := locales[lang]
+		if !ok {
+			continue
+		}
+		for i := range names {
+			for _, n := range names[i] {
+				if n == name {
+					return time.January + time.Month(i), true
+				}
+			}
+		}
+	}
+	return 0, false
+}
+
+// isDigits reports whether s consists entirely of ASCII decimal digits.
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if !isDigit(s[i]) {
+			return false
+		}
+	}
+	return s != ""
+}
@@ -0,0 +1,91 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseLocalizedDate(t *testing.T) {
+	for _, test := range []struct {
+		s     string
+		langs []string
+		want  Date
+	}{
+		{"29 Feb 2020", nil, Date{2020, 2, 29}},
+		{"29 February 2020", nil, Date{2020, 2, 29}},
+		{"February 29, 2020", nil, Date{2020, 2, 29}},
+		{"Sept. 1, 2021", nil, Date{2021, 9, 1}},
+		{"1er février 2020", nil, Date{2020, 2, 1}},
+		{"1 FEVR. 2020", nil, Date{2020, 2, 1}},
+		{"3. März 2021", nil, Date{2021, 3, 3}},
+		{"3 Jänner 2021", nil, Date{2021, 1, 3}},
+		{"1 de enero de 2020", nil, Date{2020, 1, 1}},
+		{"25 dicembre 2024", nil, Date{2024, 12, 25}},
+		{"7 de março de 2022", nil, Date{2022, 3, 7}},
+		{"12 mrt 2023", nil, Date{2023, 3, 12}},
+		// "mai" is May in French and German, but Portuguese for maio.
+		{"5 mai 2024", []string{"pt"}, Date{2024, 5, 5}},
+		{"5 mai 2024", []string{"fr"}, Date{2024, 5, 5}},
+		// "mar" is March in English and Spanish.
+		{"2 mar 2024", []string{"es"}, Date{2024, 3, 2}},
+	} {
+		got, err := ParseLocalizedDate(test.s, test.langs...)
+		if err != nil || got != test.want {
+			t.Errorf("ParseLocalizedDate(%q, %q) = %v, %v, want %v", test.s, test.langs, got, err, test.want)
+		}
+	}
+}
+
+func TestParseLocalizedDateRejects(t *testing.T) {
+	for _, test := range []struct {
+		s     string
+		langs []string
+	}{
+		{"", nil},
+		{"29 Feb", nil},
+		{"Feb 2020", nil},
+		{"30 Feb 2020", nil},
+		{"29 Foo 2020", nil},
+		{"123 Feb 2020", nil},
+		{"1 2 Feb 2020", nil},
+		{"1 Feb 2020 2021", nil},
+		{"1 Feb March 2020", nil},
+		{"1 Feb 20", nil},
+		{"1 février 2020", []string{"en"}},
+		{"1 Feb 2020", []string{"xx"}},
+	} {
+		if got, err := ParseLocalizedDate(test.s, test.langs...); err == nil {
+			t.Errorf("ParseLocalizedDate(%q, %q) = %v, want error", test.s, test.langs, got)
+		}
+	}
+}
+
+func TestRegisterMonthNames(t *testing.T) {
+	names := MonthNames{
+		{"Styczeń", "Sty"}, {"Luty", "Lut"}, {"Marzec", "Mar"}, {"Kwiecień", "Kwi"},
+		{"Maj"}, {"Czerwiec", "Cze"}, {"Lipiec", "Lip"}, {"Sierpień", "Sie"},
+		{"Wrzesień", "Wrz"}, {"Październik", "Paź"}, {"Listopad", "Lis"}, {"Grudzień", "Gru"},
+	}
+	RegisterMonthNames("pl-test", names)
+	if got, err := ParseLocalizedDate("11 listopad 2024", "pl-test"); err != nil || got != (Date{2024, time.November, 11}) {
+		t.Errorf("ParseLocalizedDate(11 listopad 2024, pl-test) = %v, %v", got, err)
+	}
+	// A registered language is also tried when none is named.
+	if got, err := ParseLocalizedDate("3 PAŹ 2024"); err != nil || got != (Date{2024, time.October, 3}) {
+		t.Errorf("ParseLocalizedDate(3 PAŹ 2024) = %v, %v", got, err)
+	}
+}
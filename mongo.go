@@ -0,0 +1,91 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// ExtendedJSONDateTime is a DateTime that is represented in JSON as a
+// MongoDB Extended JSON date, such as {"$date": "2020-02-29T03:42:31.000Z"}
+// or {"$date": {"$numberLong": "1582947751000"}}. It is intended for tools
+// that migrate data exported from MongoDB.
+//
+// MongoDB dates are instants. ExtendedJSONDateTime holds their wall-clock
+// value in UTC.
+type ExtendedJSONDateTime DateTime
+
+// MarshalJSON implements the json.Marshaler interface.
+// The output is in the relaxed Extended JSON format, with milliseconds.
+func (dt ExtendedJSONDateTime) MarshalJSON() ([]byte, error) {
+	s := DateTime(dt).In(time.UTC).Format("2006-01-02T15:04:05.000Z")
+	return json.Marshal(map[string]string{"$date": s})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It accepts both the canonical and the relaxed Extended JSON formats, and
+// also a plain string in a format accepted by ParseDateTime.
+func (dt *ExtendedJSONDateTime) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		v, err := ParseDateTime(s)
+		if err != nil {
+			return err
+		}
+		*dt = ExtendedJSONDateTime(v)
+		return nil
+	}
+	var wrapper struct {
+		Date *json.RawMessage `json:"$date"`
+	}
+	if err := json.Unmarshal(data, &wrapper); err != nil {
+		return err
+	}
+	if wrapper.Date == nil {
+		return fmt.Errorf("civil: missing $date in %s", data)
+	}
+	t, err := parseExtendedJSONDate(*wrapper.Date)
+	if err != nil {
+		return err
+	}
+	*dt = ExtendedJSONDateTime(DateTimeOf(t.UTC()))
+	return nil
+}
+
+// parseExtendedJSONDate parses the value of a $date key: an RFC3339
+// string, {"$numberLong": "<milliseconds>"}, or a number of milliseconds
+// since the Unix epoch.
+func parseExtendedJSONDate(data []byte) (time.Time, error) {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		return time.Parse(time.RFC3339Nano, s)
+	}
+	var long struct {
+		NumberLong *string `json:"$numberLong"`
+	}
+	if err := json.Unmarshal(data, &long); err == nil && long.NumberLong != nil {
+		s = *long.NumberLong
+	} else {
+		s = string(data)
+	}
+	ms, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("civil: invalid $date value %s", data)
+	}
+	return time.UnixMilli(ms), nil
+}
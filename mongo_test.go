@@ -0,0 +1,66 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestExtendedJSONDateTime(t *testing.T) {
+	want := ExtendedJSONDateTime{Date{2020, 2, 29}, Time{3, 42, 31, 0}}
+	for _, data := range []string{
+		`{"$date": "2020-02-29T03:42:31.000Z"}`,
+		`{"$date": "2020-02-29T03:42:31Z"}`,
+		`{"$date": "2020-02-29T05:42:31+02:00"}`,
+		`{"$date": {"$numberLong": "1582947751000"}}`,
+		`{"$date": 1582947751000}`,
+		`"2020-02-29T03:42:31"`,
+	} {
+		var got ExtendedJSONDateTime
+		if err := json.Unmarshal([]byte(data), &got); err != nil || got != want {
+			t.Errorf("json.Unmarshal(%s) = %v, %v, want %v", data, got, err, want)
+		}
+	}
+
+	for _, test := range []struct {
+		dt   ExtendedJSONDateTime
+		want string
+	}{
+		{want, `{"$date":"2020-02-29T03:42:31.000Z"}`},
+		// Extended JSON dates have millisecond precision.
+		{ExtendedJSONDateTime{Date{1969, 12, 31}, Time{23, 59, 59, 999999999}}, `{"$date":"1969-12-31T23:59:59.999Z"}`},
+	} {
+		data, err := json.Marshal(test.dt)
+		if err != nil || string(data) != test.want {
+			t.Errorf("json.Marshal(%v) = %s, %v, want %s", test.dt, data, err, test.want)
+		}
+	}
+
+	for _, data := range []string{
+		`{}`,
+		`{"date": "2020-02-29T03:42:31Z"}`,
+		`{"$date": "2020-02-29T03:42:31"}`,
+		`{"$date": {"$numberLong": "15829x"}}`,
+		`{"$date": 1.5}`,
+		`"2020-02-30T03:42:31"`,
+		`42`,
+	} {
+		var got ExtendedJSONDateTime
+		if err := json.Unmarshal([]byte(data), &got); err == nil {
+			t.Errorf("json.Unmarshal(%s) = %v, want error", data, got)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"fmt"
+	"time"
+)
+
+// A MonthDay represents a day of the year without a year, such as a
+// birthday or an annual deadline.
+type MonthDay struct {
+	Month time.Month // Month of the year (January = 1, ...).
+	Day   int        // Day of the month, starting at 1.
+}
+
+// MonthDayOf returns the MonthDay of d.
+func MonthDayOf(d Date) MonthDay {
+	return MonthDay{Month: d.Month, Day: d.Day}
+}
+
+// ParseMonthDay parses a string in the ISO 8601 format --MM-DD, as in
+// "--02-29", and returns the MonthDay it represents.
+func ParseMonthDay(s string) (MonthDay, error) {
+	if len(s) != 7 || s[0] != '-' || s[1] != '-' || s[4] != '-' {
+		return MonthDay{}, fmt.Errorf("civil: cannot parse %q as a month and day", s)
+	}
+	// Parse in a leap year so that February 29 is accepted.
+	t, err := time.Parse("2006-01-02", "2000"+s[1:])
+	if err != nil {
+		return MonthDay{}, fmt.Errorf("civil: cannot parse %q as a month and day", s)
+	}
+	return MonthDay{Month: t.Month(), Day: t.Day()}, nil
+}
+
+// String returns the month and day in the ISO 8601 format --MM-DD.
+func (md MonthDay) String() string {
+	return fmt.Sprintf("--%02d-%02d", md.Month, md.Day)
+}
+
+// IsValid reports whether the month and day occur in some year. February 29
+// is valid.
+func (md MonthDay) IsValid() bool {
+	return Date{Year: 2000, Month: md.Month, Day: md.Day}.IsValid()
+}
+
+// Before reports whether md1 occurs before md2 in a year.
+func (md1 MonthDay) Before(md2 MonthDay) bool {
+	if md1.Month != md2.Month {
+		return md1.Month < md2.Month
+	}
+	return md1.Day < md2.Day
+}
+
+// After reports whether md1 occurs after md2 in a year.
+func (md1 MonthDay) After(md2 MonthDay) bool {
+	return md2.Before(md1)
+}
+
+// Compare compares md1 and md2. If md1 is before md2, it returns -1;
+// if md1 is after md2, it returns +1; otherwise it returns 0.
+func (md1 MonthDay) Compare(md2 MonthDay) int {
+	switch {
+	case md1.Before(md2):
+		return -1
+	case md1.After(md2):
+		return +1
+	}
+	return 0
+}
+
+// InYear returns the date of md in year. February 29 is observed on
+// February 28 in a year that is not a leap year; use InYearWithPolicy to
+// choose otherwise.
+func (md MonthDay) InYear(year int) Date {
+	d, _ := ObserveFeb28.resolve(year, md.Month, md.Day)
+	return d
+}
+
+// InYearWithPolicy returns the date of md in year, observing February 29
+// according to p. It reports false if p is SkipLeapDay and year has no
+// February 29.
+func (md MonthDay) InYearWithPolicy(year int, p LeapDayPolicy) (Date, bool) {
+	return p.resolve(year, md.Month, md.Day)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// The output is the result of md.String().
+func (md MonthDay) MarshalText() ([]byte, error) {
+	return []byte(md.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// The value is expected to be a string in a format accepted by
+// ParseMonthDay.
+func (md *MonthDay) UnmarshalText(data []byte) error {
+	var err error
+	*md, err = ParseMonthDay(string(data))
+	return err
+}
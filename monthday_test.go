@@ -0,0 +1,121 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseMonthDay(t *testing.T) {
+	for _, test := range []struct {
+		s    string
+		want MonthDay
+	}{
+		{"--01-01", MonthDay{1, 1}},
+		{"--02-29", MonthDay{2, 29}},
+		{"--12-31", MonthDay{12, 31}},
+	} {
+		got, err := ParseMonthDay(test.s)
+		if err != nil || got != test.want {
+			t.Errorf("ParseMonthDay(%q) = %v, %v, want %v", test.s, got, err, test.want)
+			continue
+		}
+		if s := got.String(); s != test.s {
+			t.Errorf("%#v.String() = %q, want %q", got, s, test.s)
+		}
+		var u MonthDay
+		if err := u.UnmarshalText([]byte(test.s)); err != nil || u != test.want {
+			t.Errorf("UnmarshalText(%q) = %v, %v, want %v", test.s, u, err, test.want)
+		}
+	}
+	for _, s := range []string{"", "02-29", "--2-29", "--02-30", "--13-01", "--00-10", "2024-02-29", "--02/29", "-+02-29"} {
+		if got, err := ParseMonthDay(s); err == nil {
+			t.Errorf("ParseMonthDay(%q) = %v, want error", s, got)
+		}
+	}
+}
+
+func TestMonthDayIsValid(t *testing.T) {
+	for _, test := range []struct {
+		md   MonthDay
+		want bool
+	}{
+		{MonthDay{2, 29}, true},
+		{MonthDay{2, 30}, false},
+		{MonthDay{4, 31}, false},
+		{MonthDay{12, 31}, true},
+		{MonthDay{0, 1}, false},
+		{MonthDay{1, 0}, false},
+	} {
+		if got := test.md.IsValid(); got != test.want {
+			t.Errorf("%#v.IsValid() = %t, want %t", test.md, got, test.want)
+		}
+	}
+}
+
+func TestMonthDayCompare(t *testing.T) {
+	feb29, mar1, dec31 := MonthDay{2, 29}, MonthDay{3, 1}, MonthDay{12, 31}
+	for _, test := range []struct {
+		a, b MonthDay
+		want int
+	}{
+		{feb29, mar1, -1},
+		{mar1, feb29, 1},
+		{feb29, feb29, 0},
+		{MonthDay{2, 28}, feb29, -1},
+		{dec31, MonthDay{1, 1}, 1},
+	} {
+		if got := test.a.Compare(test.b); got != test.want {
+			t.Errorf("%v.Compare(%v) = %d, want %d", test.a, test.b, got, test.want)
+		}
+		if got := test.a.Before(test.b); got != (test.want < 0) {
+			t.Errorf("%v.Before(%v) = %t", test.a, test.b, got)
+		}
+		if got := test.a.After(test.b); got != (test.want > 0) {
+			t.Errorf("%v.After(%v) = %t", test.a, test.b, got)
+		}
+	}
+}
+
+func TestMonthDayInYear(t *testing.T) {
+	feb29 := MonthDayOf(Date{2024, 2, 29})
+	for _, test := range []struct {
+		year   int
+		p      LeapDayPolicy
+		want   Date
+		wantOK bool
+	}{
+		{2028, ObserveFeb28, Date{2028, 2, 29}, true},
+		{2025, ObserveFeb28, Date{2025, 2, 28}, true},
+		{2025, ObserveMar1, Date{2025, 3, 1}, true},
+		{2025, SkipLeapDay, Date{}, false},
+		{2100, ObserveMar1, Date{2100, 3, 1}, true},
+		{2000, SkipLeapDay, Date{2000, 2, 29}, true},
+	} {
+		got, ok := feb29.InYearWithPolicy(test.year, test.p)
+		if got != test.want || ok != test.wantOK {
+			t.Errorf("%v.InYearWithPolicy(%d, %v) = %v, %t, want %v, %t", feb29, test.year, test.p, got, ok, test.want, test.wantOK)
+		}
+		if test.p == ObserveFeb28 {
+			if got := feb29.InYear(test.year); got != test.want {
+				t.Errorf("%v.InYear(%d) = %v, want %v", feb29, test.year, got, test.want)
+			}
+		}
+	}
+	if got, want := (MonthDay{time.July, 4}).InYear(2025), (Date{2025, 7, 4}); got != want {
+		t.Errorf("--07-04.InYear(2025) = %v, want %v", got, want)
+	}
+}
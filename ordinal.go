@@ -0,0 +1,56 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import "fmt"
+
+// ParseOrdinalDate parses an English date with an ordinal day, such as
+// "March 3rd, 2024", "3rd March 2024" or "the 3rd of March 2024". Parsing
+// is lenient: the ordinal suffix and comma are optional, the month may be
+// abbreviated, and case is ignored. It is equivalent to
+// ParseLocalizedDate(s, "en").
+func ParseOrdinalDate(s string) (Date, error) {
+	return ParseLocalizedDate(s, "en")
+}
+
+// FormatOrdinalDate formats d in English with an ordinal day, as
+// "March 3rd, 2024", or if dayFirst is true, as "3rd March 2024".
+func FormatOrdinalDate(d Date, dayFirst bool) string {
+	day := fmt.Sprintf("%d%s", d.Day, OrdinalSuffix(d.Day))
+	if dayFirst {
+		return fmt.Sprintf("%s %v %d", day, d.Month, d.Year)
+	}
+	return fmt.Sprintf("%v %s, %d", d.Month, day, d.Year)
+}
+
+// OrdinalSuffix returns the English ordinal suffix for n: "st", "nd", "rd"
+// or "th".
+func OrdinalSuffix(n int) string {
+	if n < 0 {
+		n = -n
+	}
+	if n%100 >= 11 && n%100 <= 13 {
+		return "th"
+	}
+	switch n % 10 {
+	case 1:
+		return "st"
+	case 2:
+		return "nd"
+	case 3:
+		return "rd"
+	}
+	return "th"
+}
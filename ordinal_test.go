@@ -0,0 +1,84 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import "testing"
+
+func TestOrdinalSuffix(t *testing.T) {
+	for n, want := range map[int]string{
+		0: "th", 1: "st", 2: "nd", 3: "rd", 4: "th", 10: "th",
+		11: "th", 12: "th", 13: "th", 21: "st", 22: "nd", 23: "rd",
+		31: "st", 101: "st", 111: "th", 112: "th", -1: "st", -12: "th",
+	} {
+		if got := OrdinalSuffix(n); got != want {
+			t.Errorf("OrdinalSuffix(%d) = %q, want %q", n, got, want)
+		}
+	}
+}
+
+func TestOrdinalDateRoundTrip(t *testing.T) {
+	for _, test := range []struct {
+		d                    Date
+		monthFirst, dayFirst string
+	}{
+		{Date{2024, 3, 3}, "March 3rd, 2024", "3rd March 2024"},
+		{Date{2024, 3, 1}, "March 1st, 2024", "1st March 2024"},
+		{Date{2024, 2, 22}, "February 22nd, 2024", "22nd February 2024"},
+		{Date{2024, 12, 11}, "December 11th, 2024", "11th December 2024"},
+		{Date{2024, 5, 31}, "May 31st, 2024", "31st May 2024"},
+	} {
+		for _, p := range []struct {
+			dayFirst bool
+			want     string
+		}{{false, test.monthFirst}, {true, test.dayFirst}} {
+			s := FormatOrdinalDate(test.d, p.dayFirst)
+			if s != p.want {
+				t.Errorf("FormatOrdinalDate(%v, %t) = %q, want %q", test.d, p.dayFirst, s, p.want)
+			}
+			if got, err := ParseOrdinalDate(s); err != nil || got != test.d {
+				t.Errorf("ParseOrdinalDate(%q) = %v, %v, want %v", s, got, err, test.d)
+			}
+		}
+	}
+}
+
+func TestParseOrdinalDate(t *testing.T) {
+	want := Date{2024, 3, 3}
+	for _, s := range []string{
+		"March 3rd, 2024",
+		"march 3rd 2024",
+		"Mar 3, 2024",
+		"3rd March 2024",
+		"3RD MAR 2024",
+		"the 3rd of March 2024",
+		"the 3rd of March, 2024",
+	} {
+		if got, err := ParseOrdinalDate(s); err != nil || got != want {
+			t.Errorf("ParseOrdinalDate(%q) = %v, %v, want %v", s, got, err, want)
+		}
+	}
+	for _, s := range []string{
+		"",
+		"March 2024",
+		"March 32nd, 2024",
+		"3rd Mars 2024",
+		"3 mars 2024",
+		"March 3rd, 24",
+	} {
+		if got, err := ParseOrdinalDate(s); err == nil {
+			t.Errorf("ParseOrdinalDate(%q) = %v, want error", s, got)
+		}
+	}
+}
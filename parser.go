@@ -0,0 +1,319 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// A Parser parses civil values, optionally accepting input that the
+// package-level parse functions reject.
+//
+// The zero Parser is exactly as strict as ParseDate, ParseTime and
+// ParseDateTime.
+type Parser struct {
+	// AllowZ permits a trailing 'Z' (or 'z') after a time. The designator
+	// is discarded and the value is treated as civil: no conversion from
+	// UTC takes place.
+	AllowZ bool
+
+	// AllowUnpadded permits the month, day, minute and second to be written
+	// without a leading zero, as in "2020-2-9" and "3:7:5". The year must
+	// still have four digits.
+	AllowUnpadded bool
+
+	// AllowAnnotations permits RFC 9557 suffix annotations, such as the
+	// "[u-ca=iso8601]" and "[America/New_York]" emitted by JavaScript's
+	// Temporal API. Time zone annotations and elective annotations are
+	// discarded. A calendar annotation other than "iso8601" and an
+	// unrecognized critical annotation are errors. Use SplitAnnotations to
+	// inspect the annotations instead.
+	AllowAnnotations bool
+
+	// NormalizeDigits permits decimal digits from any script, such as the
+	// full-width digits of Japanese and Chinese input, and full-width forms
+	// of the ASCII punctuation, as in "２０２０－０２－２９".
+	NormalizeDigits bool
+
+	// AllowEndOfDay permits the ISO 8601 end-of-day time "24:00:00", with
+	// an optional fractional part of zeros. ParseTime returns it as
+	// EndOfDay, and ParseDateTime normalizes it to midnight at the start of
+	// the next day, so that "2020-02-28T24:00:00" is 2020-02-29T00:00:00.
+	AllowEndOfDay bool
+
+	// LeapSeconds specifies how a time whose seconds are 60, such as the
+	// leap second 23:59:60, is treated. By default it is rejected.
+	LeapSeconds LeapSecondPolicy
+}
+
+// ParseDate parses a string in the format accepted by ParseDate, subject to
+// the options set on p.
+func (p Parser) ParseDate(s string) (Date, error) {
+	s, err := p.stripAnnotations(p.normalize(s))
+	if err != nil {
+		return Date{}, err
+	}
+	if !p.AllowUnpadded {
+		return ParseDate(s)
+	}
+	t, err := time.Parse("2006-1-2", s)
+	if err != nil {
+		return Date{}, err
+	}
+	return DateOf(t), nil
+}
+
+// ParseTime parses a string in the format accepted by ParseTime, subject to
+// the options set on p.
+func (p Parser) ParseTime(s string) (Time, error) {
+	s, err := p.trim(p.normalize(s))
+	if err != nil {
+		return Time{}, err
+	}
+	if p.AllowEndOfDay && isEndOfDay(s) {
+		return EndOfDay, nil
+	}
+	if s, ok := cutLeapSecond(s); ok && p.LeapSeconds != RejectLeapSecond {
+		t, err := Parser{AllowUnpadded: p.AllowUnpadded}.ParseTime(s)
+		if err != nil {
+			return Time{}, err
+		}
+		return p.LeapSeconds.apply(t), nil
+	}
+	if !p.AllowUnpadded {
+		return ParseTime(s)
+	}
+	t, err := time.Parse("15:4:5.999999999", normalizeFraction(s))
+	if err != nil {
+		return Time{}, err
+	}
+	return TimeOf(t), nil
+}
+
+// ParseDateTime parses a string in the format accepted by ParseDateTime,
+// subject to the options set on p.
+func (p Parser) ParseDateTime(s string) (DateTime, error) {
+	s, err := p.trim(p.normalize(s))
+	if err != nil {
+		return DateTime{}, err
+	}
+	if i := strings.IndexAny(s, "Tt"); p.AllowEndOfDay && i >= 0 && isEndOfDay(s[i+1:]) {
+		d, err := p.ParseDate(s[:i])
+		if err != nil {
+			return DateTime{}, err
+		}
+		return DateTime{Date: d.AddDays(1)}, nil
+	}
+	if s, ok := cutLeapSecond(s); ok && p.LeapSeconds != RejectLeapSecond {
+		dt, err := Parser{AllowUnpadded: p.AllowUnpadded}.ParseDateTime(s)
+		if err != nil {
+			return DateTime{}, err
+		}
+		dt.Time = p.LeapSeconds.apply(dt.Time)
+		return dt, nil
+	}
+	if !p.AllowUnpadded {
+		return ParseDateTime(s)
+	}
+	s = normalizeFraction(s)
+	t, err := time.Parse("2006-1-2T15:4:5.999999999", s)
+	if err != nil {
+		t, err = time.Parse("2006-1-2t15:4:5.999999999", s)
+		if err != nil {
+			return DateTime{}, err
+		}
+	}
+	return DateTimeOf(t), nil
+}
+
+// isEndOfDay reports whether s is the end-of-day time "24:00:00", with an
+// optional fractional part of zeros.
+func isEndOfDay(s string) bool {
+	rest, ok := strings.CutPrefix(s, "24:00:00")
+	if !ok {
+		return false
+	}
+	if rest == "" {
+		return true
+	}
+	if len(rest) < 2 || (rest[0] != '.' && rest[0] != ',') {
+		return false
+	}
+	return strings.Trim(rest[1:], "0") == ""
+}
+
+// normalize maps the digits and full-width punctuation of s to ASCII if p
+// permits them.
+func (p Parser) normalize(s string) string {
+	if !p.NormalizeDigits {
+		return s
+	}
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r < 0x80:
+			return r
+		case 0xFF01 <= r && r <= 0xFF5E:
+			// Full-width forms of the printable ASCII characters.
+			return r - 0xFF01 + '!'
+		case unicode.IsDigit(r):
+			return '0' + digitValue(r)
+		}
+		return r
+	}, s)
+}
+
+// digitValue returns the value of the Unicode decimal digit r. Each range of
+// the Nd category consists of whole runs of ten digits starting at zero.
+func digitValue(r rune) rune {
+	for _, rg := range unicode.Nd.R16 {
+		if rune(rg.Lo) <= r && r <= rune(rg.Hi) {
+			return (r - rune(rg.Lo)) % 10
+		}
+	}
+	for _, rg := range unicode.Nd.R32 {
+		if rune(rg.Lo) <= r && r <= rune(rg.Hi) {
+			return (r - rune(rg.Lo)) % 10
+		}
+	}
+	return 0
+}
+
+// trim removes any suffixes from s that p has been configured to discard.
+func (p Parser) trim(s string) (string, error) {
+	s, err := p.stripAnnotations(s)
+	if err != nil {
+		return "", err
+	}
+	if p.AllowZ && len(s) > 0 && (s[len(s)-1] == 'Z' || s[len(s)-1] == 'z') {
+		s = s[:len(s)-1]
+	}
+	return s, nil
+}
+
+// stripAnnotations removes any RFC 9557 annotations from s if p permits them.
+func (p Parser) stripAnnotations(s string) (string, error) {
+	if !p.AllowAnnotations {
+		return s, nil
+	}
+	s, annotations, err := SplitAnnotations(s)
+	if err != nil {
+		return "", err
+	}
+	for _, a := range annotations {
+		switch {
+		case a.Key == "u-ca":
+			if a.Value != "iso8601" {
+				return "", fmt.Errorf("civil: unsupported calendar %q", a.Value)
+			}
+		case a.Key != "" && a.Critical:
+			return "", fmt.Errorf("civil: unsupported critical annotation %q", a.Key)
+		}
+	}
+	return s, nil
+}
+
+// An Annotation is an RFC 9557 suffix annotation, such as "[u-ca=iso8601]"
+// or "[America/New_York]".
+type Annotation struct {
+	Key      string // The annotation key, such as "u-ca"; empty for a time zone.
+	Value    string // The annotation value, or the time zone name.
+	Critical bool   // Whether the annotation was marked critical with '!'.
+}
+
+// String returns the annotation in the bracketed form defined by RFC 9557.
+func (a Annotation) String() string {
+	var b strings.Builder
+	b.WriteByte('[')
+	if a.Critical {
+		b.WriteByte('!')
+	}
+	if a.Key != "" {
+		b.WriteString(a.Key)
+		b.WriteByte('=')
+	}
+	b.WriteString(a.Value)
+	b.WriteByte(']')
+	return b.String()
+}
+
+// SplitAnnotations splits the RFC 9557 suffix annotations from the end of s.
+// It returns the remainder of s and the annotations in the order in which
+// they appeared. If s has no annotations, SplitAnnotations returns s
+// unchanged and a nil slice.
+func SplitAnnotations(s string) (string, []Annotation, error) {
+	i := strings.IndexByte(s, '[')
+	if i < 0 {
+		return s, nil, nil
+	}
+	rest, suffix := s[:i], s[i:]
+	var annotations []Annotation
+	for suffix != "" {
+		end := strings.IndexByte(suffix, ']')
+		if suffix[0] != '[' || end < 0 {
+			return "", nil, fmt.Errorf("civil: malformed annotation in %q", s)
+		}
+		a, err := parseAnnotation(suffix[1:end])
+		if err != nil {
+			return "", nil, fmt.Errorf("civil: %v in %q", err, s)
+		}
+		annotations = append(annotations, a)
+		suffix = suffix[end+1:]
+	}
+	return rest, annotations, nil
+}
+
+// parseAnnotation parses the contents of a bracketed annotation.
+func parseAnnotation(s string) (Annotation, error) {
+	var a Annotation
+	if strings.HasPrefix(s, "!") {
+		a.Critical = true
+		s = s[1:]
+	}
+	if strings.ContainsAny(s, "[]") {
+		return Annotation{}, fmt.Errorf("malformed annotation %q", s)
+	}
+	key, value, ok := strings.Cut(s, "=")
+	if !ok {
+		if s == "" {
+			return Annotation{}, fmt.Errorf("empty annotation")
+		}
+		a.Value = s
+		return a, nil
+	}
+	if !isAnnotationKey(key) || value == "" {
+		return Annotation{}, fmt.Errorf("malformed annotation %q", s)
+	}
+	a.Key, a.Value = key, value
+	return a, nil
+}
+
+// isAnnotationKey reports whether s is a valid RFC 9557 annotation key:
+// a lower-case letter or underscore followed by lower-case letters, digits,
+// underscores and hyphens.
+func isAnnotationKey(s string) bool {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'a' <= c && c <= 'z', c == '_':
+		case i > 0 && (isDigit(c) || c == '-'):
+		default:
+			return false
+		}
+	}
+	return s != ""
+}
@@ -0,0 +1,210 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import "testing"
+
+func TestParserAllowZ(t *testing.T) {
+	dt := DateTime{Date{2024, 3, 1}, Time{9, 30, 0, 0}}
+	for _, test := range []struct {
+		s    string
+		want DateTime
+		ok   bool
+	}{
+		{"2024-03-01T09:30:00", dt, true},
+		{"2024-03-01T09:30:00Z", dt, true},
+		{"2024-03-01T09:30:00z", dt, true},
+		{"2024-03-01T09:30:00ZZ", DateTime{}, false},
+		{"2024-03-01T09:30:00+00:00", DateTime{}, false},
+	} {
+		got, err := Parser{AllowZ: true}.ParseDateTime(test.s)
+		if (err == nil) != test.ok || got != test.want {
+			t.Errorf("ParseDateTime(%q) = %v, %v, want %v, ok %t", test.s, got, err, test.want, test.ok)
+		}
+	}
+	if got, err := (Parser{AllowZ: true}).ParseTime("09:30:00Z"); err != nil || got != dt.Time {
+		t.Errorf("ParseTime(09:30:00Z) = %v, %v, want %v", got, err, dt.Time)
+	}
+	// The zero Parser is as strict as the package-level functions.
+	if got, err := (Parser{}).ParseDateTime("2024-03-01T09:30:00Z"); err == nil {
+		t.Errorf("Parser{}.ParseDateTime(2024-03-01T09:30:00Z) = %v, want error", got)
+	}
+	if got, err := (Parser{}).ParseTime("09:30:00Z"); err == nil {
+		t.Errorf("Parser{}.ParseTime(09:30:00Z) = %v, want error", got)
+	}
+}
+
+func TestParserAllowUnpadded(t *testing.T) {
+	p := Parser{AllowUnpadded: true}
+	for _, test := range []struct {
+		s    string
+		want Date
+		ok   bool
+	}{
+		{"2020-2-9", Date{2020, 2, 9}, true},
+		{"2020-02-09", Date{2020, 2, 9}, true},
+		{"2020-12-1", Date{2020, 12, 1}, true},
+		{"2020-2-30", Date{}, false},
+		{"20-2-9", Date{}, false},
+	} {
+		got, err := p.ParseDate(test.s)
+		if (err == nil) != test.ok || got != test.want {
+			t.Errorf("ParseDate(%q) = %v, %v, want %v, ok %t", test.s, got, err, test.want, test.ok)
+		}
+	}
+	if got, err := p.ParseTime("3:7:5"); err != nil || got != (Time{3, 7, 5, 0}) {
+		t.Errorf("ParseTime(3:7:5) = %v, %v", got, err)
+	}
+	if got, err := p.ParseTime("3:7:5,25"); err != nil || got != (Time{3, 7, 5, 250000000}) {
+		t.Errorf("ParseTime(3:7:5,25) = %v, %v", got, err)
+	}
+	want := DateTime{Date{2020, 2, 9}, Time{3, 7, 5, 0}}
+	for _, s := range []string{"2020-2-9T3:7:5", "2020-2-9t03:07:05"} {
+		if got, err := p.ParseDateTime(s); err != nil || got != want {
+			t.Errorf("ParseDateTime(%q) = %v, %v, want %v", s, got, err, want)
+		}
+	}
+	if got, err := (Parser{}).ParseDate("2020-2-9"); err == nil {
+		t.Errorf("Parser{}.ParseDate(2020-2-9) = %v, want error", got)
+	}
+}
+
+func TestSplitAnnotations(t *testing.T) {
+	for _, test := range []struct {
+		s    string
+		rest string
+		want []Annotation
+		ok   bool
+	}{
+		{"2024-03-01", "2024-03-01", nil, true},
+		{"2024-03-01T09:00:00[America/New_York]", "2024-03-01T09:00:00", []Annotation{{Value: "America/New_York"}}, true},
+		{"2024-03-01[u-ca=iso8601][!x-foo=bar]", "2024-03-01",
+			[]Annotation{{Key: "u-ca", Value: "iso8601"}, {Key: "x-foo", Value: "bar", Critical: true}}, true},
+		{"2024-03-01[", "", nil, false},
+		{"2024-03-01[]", "", nil, false},
+		{"2024-03-01[u-ca=]", "", nil, false},
+		{"2024-03-01[U-CA=iso8601]", "", nil, false},
+		{"2024-03-01[a]x", "", nil, false},
+	} {
+		rest, got, err := SplitAnnotations(test.s)
+		if (err == nil) != test.ok || rest != test.rest || len(got) != len(test.want) {
+			t.Errorf("SplitAnnotations(%q) = %q, %v, %v, want %q, %v, ok %t", test.s, rest, got, err, test.rest, test.want, test.ok)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		var back string
+		for i, a := range got {
+			if a != test.want[i] {
+				t.Errorf("SplitAnnotations(%q)[%d] = %+v, want %+v", test.s, i, a, test.want[i])
+			}
+			back += a.String()
+		}
+		if rest+back != test.s {
+			t.Errorf("SplitAnnotations(%q) does not round-trip: %q", test.s, rest+back)
+		}
+	}
+}
+
+func TestParserAllowAnnotations(t *testing.T) {
+	p := Parser{AllowAnnotations: true}
+	want := DateTime{Date{2024, 3, 1}, Time{Hour: 9}}
+	for _, s := range []string{
+		"2024-03-01T09:00:00[America/New_York]",
+		"2024-03-01T09:00:00[u-ca=iso8601]",
+		"2024-03-01T09:00:00[!Europe/Paris][x-elective=1]",
+	} {
+		if got, err := p.ParseDateTime(s); err != nil || got != want {
+			t.Errorf("ParseDateTime(%q) = %v, %v, want %v", s, got, err, want)
+		}
+	}
+	for _, s := range []string{
+		"2024-03-01T09:00:00[u-ca=hebrew]",
+		"2024-03-01T09:00:00[!x-critical=1]",
+		"2024-03-01T09:00:00[bad",
+	} {
+		if got, err := p.ParseDateTime(s); err == nil {
+			t.Errorf("ParseDateTime(%q) = %v, want error", s, got)
+		}
+	}
+	if got, err := p.ParseDate("2024-03-01[u-ca=iso8601]"); err != nil || got != want.Date {
+		t.Errorf("ParseDate(2024-03-01[u-ca=iso8601]) = %v, %v", got, err)
+	}
+	if got, err := (Parser{}).ParseDateTime("2024-03-01T09:00:00[UTC]"); err == nil {
+		t.Errorf("Parser{}.ParseDateTime(2024-03-01T09:00:00[UTC]) = %v, want error", got)
+	}
+}
+
+func TestParserNormalizeDigits(t *testing.T) {
+	p := Parser{NormalizeDigits: true}
+	for _, test := range []struct {
+		s    string
+		want Date
+	}{
+		{"２０２０－０２－２９", Date{2020, 2, 29}},
+		{"٢٠٢٠-٠٢-٢٩", Date{2020, 2, 29}},
+		{"२०२०-०२-२९", Date{2020, 2, 29}},
+		{"2020-02-29", Date{2020, 2, 29}},
+	} {
+		if got, err := p.ParseDate(test.s); err != nil || got != test.want {
+			t.Errorf("ParseDate(%q) = %v, %v, want %v", test.s, got, err, test.want)
+		}
+		if got, err := (Parser{}).ParseDate(test.s); test.s != test.want.String() && err == nil {
+			t.Errorf("Parser{}.ParseDate(%q) = %v, want error", test.s, got)
+		}
+	}
+	if got, err := p.ParseTime("０９：３０：００"); err != nil || got != (Time{Hour: 9, Minute: 30}) {
+		t.Errorf("ParseTime(０９：３０：００) = %v, %v", got, err)
+	}
+	if got, err := p.ParseDate("２０２０－０２－３０"); err == nil {
+		t.Errorf("ParseDate(２０２０－０２－３０) = %v, want error", got)
+	}
+}
+
+func TestParserAllowEndOfDay(t *testing.T) {
+	p := Parser{AllowEndOfDay: true}
+	for _, s := range []string{"24:00:00", "24:00:00.000", "24:00:00,0"} {
+		if got, err := p.ParseTime(s); err != nil || !got.IsEndOfDay() {
+			t.Errorf("ParseTime(%q) = %v, %v, want EndOfDay", s, got, err)
+		}
+	}
+	for _, s := range []string{"24:00:01", "24:00:00.001", "24:00:00.", "24:01:00", "25:00:00"} {
+		if got, err := p.ParseTime(s); err == nil {
+			t.Errorf("ParseTime(%q) = %v, want error", s, got)
+		}
+	}
+	for _, test := range []struct {
+		s    string
+		want DateTime
+	}{
+		{"2020-02-28T24:00:00", DateTime{Date: Date{2020, 2, 29}}},
+		{"2020-12-31T24:00:00", DateTime{Date: Date{2021, 1, 1}}},
+		{"2020-12-31t24:00:00.0", DateTime{Date: Date{2021, 1, 1}}},
+	} {
+		if got, err := p.ParseDateTime(test.s); err != nil || got != test.want {
+			t.Errorf("ParseDateTime(%q) = %v, %v, want %v", test.s, got, err, test.want)
+		}
+	}
+	if got, err := (Parser{}).ParseTime("24:00:00"); err == nil {
+		t.Errorf("Parser{}.ParseTime(24:00:00) = %v, want error", got)
+	}
+	if EndOfDay.IsValid() || !MaxTime.Before(EndOfDay) {
+		t.Errorf("EndOfDay is valid or not after MaxTime")
+	}
+	if got, want := (DateTime{Date{2020, 2, 28}, EndOfDay}).Normalize(), (DateTime{Date: Date{2020, 2, 29}}); got != want {
+		t.Errorf("Normalize of 2020-02-28T24:00:00 = %v, want %v", got, want)
+	}
+}
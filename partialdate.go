@@ -0,0 +1,192 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"fmt"
+	"time"
+)
+
+// A DatePrecision is the finest calendar field present in a PartialDate.
+type DatePrecision int
+
+const (
+	YearPrecision  DatePrecision = iota + 1 // a year, such as "2024"
+	MonthPrecision                          // a year and month, such as "2024-06"
+	DayPrecision                            // a full date, such as "2024-06-15"
+)
+
+// String returns the name of the precision, such as "YearPrecision".
+func (p DatePrecision) String() string {
+	switch p {
+	case YearPrecision:
+		return "YearPrecision"
+	case MonthPrecision:
+		return "MonthPrecision"
+	case DayPrecision:
+		return "DayPrecision"
+	}
+	return fmt.Sprintf("DatePrecision(%d)", int(p))
+}
+
+// A PartialDate represents a date of reduced precision: a year, a year and
+// month, or a full date. A zero Month means that only the year is known, and
+// a zero Day that only the year and month are known.
+type PartialDate struct {
+	Year  int        // Year (e.g., 2014).
+	Month time.Month // Month of the year (January = 1, ...), or 0 if unknown.
+	Day   int        // Day of the month, starting at 1, or 0 if unknown.
+}
+
+// PartialDateOf returns the full-precision PartialDate of d.
+func PartialDateOf(d Date) PartialDate {
+	return PartialDate{Year: d.Year, Month: d.Month, Day: d.Day}
+}
+
+// ParsePartialDate parses a string in one of the formats YYYY, YYYY-MM and
+// YYYY-MM-DD, the reduced-precision forms of an RFC3339 full-date, and
+// returns the PartialDate it represents.
+func ParsePartialDate(s string) (PartialDate, error) {
+	switch len(s) {
+	case 4:
+		t, err := time.Parse("2006", s)
+		if err != nil {
+			return PartialDate{}, err
+		}
+		return PartialDate{Year: t.Year()}, nil
+	case 7:
+		t, err := time.Parse("2006-01", s)
+		if err != nil {
+			return PartialDate{}, err
+		}
+		return PartialDate{Year: t.Year(), Month: t.Month()}, nil
+	}
+	d, err := ParseDate(s)
+	if err != nil {
+		return PartialDate{}, err
+	}
+	return PartialDateOf(d), nil
+}
+
+// String returns the date in the format YYYY, YYYY-MM or YYYY-MM-DD,
+// according to its precision.
+func (p PartialDate) String() string {
+	switch p.Precision() {
+	case YearPrecision:
+		return fmt.Sprintf("%04d", p.Year)
+	case MonthPrecision:
+		return fmt.Sprintf("%04d-%02d", p.Year, p.Month)
+	}
+	return fmt.Sprintf("%04d-%02d-%02d", p.Year, p.Month, p.Day)
+}
+
+// Precision returns the finest field present in p.
+func (p PartialDate) Precision() DatePrecision {
+	switch {
+	case p.Month == 0:
+		return YearPrecision
+	case p.Day == 0:
+		return MonthPrecision
+	}
+	return DayPrecision
+}
+
+// IsValid reports whether the fields present in p are valid and no field
+// is present without the coarser fields, as a day without a month would be.
+func (p PartialDate) IsValid() bool {
+	switch p.Precision() {
+	case YearPrecision:
+		return p.Day == 0
+	case MonthPrecision:
+		return YearMonth{Year: p.Year, Month: p.Month}.IsValid()
+	}
+	return Date{Year: p.Year, Month: p.Month, Day: p.Day}.IsValid()
+}
+
+// Date returns the full date of p. It reports false if p has less than day
+// precision.
+func (p PartialDate) Date() (Date, bool) {
+	if p.Precision() != DayPrecision {
+		return Date{}, false
+	}
+	return Date{Year: p.Year, Month: p.Month, Day: p.Day}, true
+}
+
+// Range returns the dates that p may denote: the whole year or month for a
+// reduced-precision date, or the single date otherwise.
+func (p PartialDate) Range() DateRange {
+	switch p.Precision() {
+	case YearPrecision:
+		y := Year(p.Year)
+		return DateRange{Start: y.FirstDate(), End: y.LastDate()}
+	case MonthPrecision:
+		ym := YearMonth{Year: p.Year, Month: p.Month}
+		return DateRange{Start: ym.FirstDate(), End: ym.LastDate()}
+	}
+	d, _ := p.Date()
+	return DateRange{Start: d, End: d}
+}
+
+// Contains reports whether d is one of the dates that p may denote.
+func (p PartialDate) Contains(d Date) bool {
+	return p.Range().Contains(d)
+}
+
+// Before reports whether p1 sorts before p2. Fields are compared from the
+// year down, and a missing field sorts before any present one, so that
+// "2024" < "2024-01" < "2024-01-01" < "2024-02".
+func (p1 PartialDate) Before(p2 PartialDate) bool {
+	if p1.Year != p2.Year {
+		return p1.Year < p2.Year
+	}
+	if p1.Month != p2.Month {
+		return p1.Month < p2.Month
+	}
+	return p1.Day < p2.Day
+}
+
+// After reports whether p1 sorts after p2, in the order described for
+// Before.
+func (p1 PartialDate) After(p2 PartialDate) bool {
+	return p2.Before(p1)
+}
+
+// Compare compares p1 and p2 in the order described for Before. If p1 is
+// before p2, it returns -1; if p1 is after p2, it returns +1; otherwise it
+// returns 0.
+func (p1 PartialDate) Compare(p2 PartialDate) int {
+	switch {
+	case p1.Before(p2):
+		return -1
+	case p1.After(p2):
+		return +1
+	}
+	return 0
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// The output is the result of p.String().
+func (p PartialDate) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// The date is expected to be a string in a format accepted by
+// ParsePartialDate.
+func (p *PartialDate) UnmarshalText(data []byte) error {
+	var err error
+	*p, err = ParsePartialDate(string(data))
+	return err
+}
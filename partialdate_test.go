@@ -0,0 +1,122 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import "testing"
+
+func TestParsePartialDate(t *testing.T) {
+	for _, test := range []struct {
+		s         string
+		want      PartialDate
+		precision DatePrecision
+		r         DateRange
+	}{
+		{"2024", PartialDate{2024, 0, 0}, YearPrecision, DateRange{Date{2024, 1, 1}, Date{2024, 12, 31}}},
+		{"2024-02", PartialDate{2024, 2, 0}, MonthPrecision, DateRange{Date{2024, 2, 1}, Date{2024, 2, 29}}},
+		{"2023-02", PartialDate{2023, 2, 0}, MonthPrecision, DateRange{Date{2023, 2, 1}, Date{2023, 2, 28}}},
+		{"2024-06-15", PartialDate{2024, 6, 15}, DayPrecision, DateRange{Date{2024, 6, 15}, Date{2024, 6, 15}}},
+		{"0033", PartialDate{33, 0, 0}, YearPrecision, DateRange{Date{33, 1, 1}, Date{33, 12, 31}}},
+	} {
+		got, err := ParsePartialDate(test.s)
+		if err != nil || got != test.want {
+			t.Errorf("ParsePartialDate(%q) = %#v, %v, want %#v", test.s, got, err, test.want)
+			continue
+		}
+		if s := got.String(); s != test.s {
+			t.Errorf("%#v.String() = %q, want %q", got, s, test.s)
+		}
+		if p := got.Precision(); p != test.precision {
+			t.Errorf("%v.Precision() = %v, want %v", got, p, test.precision)
+		}
+		if !got.IsValid() {
+			t.Errorf("%v.IsValid() = false", got)
+		}
+		if r := got.Range(); r != test.r {
+			t.Errorf("%v.Range() = %v, want %v", got, r, test.r)
+		}
+		if !got.Contains(test.r.Start) || !got.Contains(test.r.End) || got.Contains(test.r.End.AddDays(1)) {
+			t.Errorf("%v.Contains disagrees with Range %v", got, test.r)
+		}
+		d, ok := got.Date()
+		if ok != (test.precision == DayPrecision) || (ok && d != test.r.Start) {
+			t.Errorf("%v.Date() = %v, %t", got, d, ok)
+		}
+	}
+	for _, s := range []string{"", "24", "202", "2024-", "2024-13", "2024-2", "2024-02-30", "2024-00", "20240615", "2024-06-15T00:00:00"} {
+		if got, err := ParsePartialDate(s); err == nil {
+			t.Errorf("ParsePartialDate(%q) = %v, want error", s, got)
+		}
+	}
+}
+
+func TestPartialDateIsValid(t *testing.T) {
+	for _, test := range []struct {
+		p    PartialDate
+		want bool
+	}{
+		{PartialDate{2024, 0, 0}, true},
+		{PartialDate{2024, 0, 5}, false},
+		{PartialDate{2024, 13, 0}, false},
+		{PartialDate{2024, 2, 29}, true},
+		{PartialDate{2023, 2, 29}, false},
+	} {
+		if got := test.p.IsValid(); got != test.want {
+			t.Errorf("%#v.IsValid() = %t, want %t", test.p, got, test.want)
+		}
+	}
+}
+
+func TestPartialDateCompare(t *testing.T) {
+	// Sorted ascending.
+	ordered := []PartialDate{
+		{2023, 12, 31},
+		{2024, 0, 0},
+		{2024, 1, 0},
+		{2024, 1, 1},
+		{2024, 1, 31},
+		{2024, 2, 0},
+		{2025, 0, 0},
+	}
+	for i, a := range ordered {
+		for j, b := range ordered {
+			want := 0
+			switch {
+			case i < j:
+				want = -1
+			case i > j:
+				want = +1
+			}
+			if got := a.Compare(b); got != want {
+				t.Errorf("%v.Compare(%v) = %d, want %d", a, b, got, want)
+			}
+			if a.Before(b) != (want < 0) || a.After(b) != (want > 0) {
+				t.Errorf("%v.Before/After(%v) disagree with Compare = %d", a, b, want)
+			}
+		}
+	}
+}
+
+func TestDatePrecisionString(t *testing.T) {
+	for p, want := range map[DatePrecision]string{
+		YearPrecision:    "YearPrecision",
+		MonthPrecision:   "MonthPrecision",
+		DayPrecision:     "DayPrecision",
+		DatePrecision(0): "DatePrecision(0)",
+	} {
+		if got := p.String(); got != want {
+			t.Errorf("DatePrecision(%d).String() = %q, want %q", int(p), got, want)
+		}
+	}
+}
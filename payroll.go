@@ -0,0 +1,164 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"fmt"
+	"iter"
+)
+
+// A PayFrequency is the frequency of a payroll schedule.
+type PayFrequency int
+
+const (
+	PayMonthly     PayFrequency = iota // calendar months
+	PaySemiMonthly                     // two periods a month
+	PayWeekly                          // every 7 days from an anchor date
+	PayBiweekly                        // every 14 days from an anchor date
+)
+
+// String returns the name of the frequency, such as "PayBiweekly".
+func (f PayFrequency) String() string {
+	switch f {
+	case PayMonthly:
+		return "PayMonthly"
+	case PaySemiMonthly:
+		return "PaySemiMonthly"
+	case PayWeekly:
+		return "PayWeekly"
+	case PayBiweekly:
+		return "PayBiweekly"
+	}
+	return fmt.Sprintf("PayFrequency(%d)", int(f))
+}
+
+// A PaySchedule describes a payroll schedule: consecutive pay periods, each
+// with a date on which it is paid.
+type PaySchedule struct {
+	Frequency PayFrequency
+
+	// Anchor is the first day of any one period of a weekly or biweekly
+	// schedule. Periods before and after it follow at the same spacing.
+	Anchor Date
+
+	// SemiMonthlyDays are the days of the month, in the range [1-28] and in
+	// ascending order, on which the periods of a semi-monthly schedule
+	// start. The zero value means the 1st and the 16th. PeriodOf and
+	// Periods panic if the days are out of range or out of order.
+	SemiMonthlyDays [2]int
+
+	// PayLag is the number of days after the end of a period on which it is
+	// paid.
+	PayLag int
+
+	// If AdjustPayDate is set, a pay date that is not a working day in
+	// Calendar is moved back to the previous working day.
+	AdjustPayDate bool
+	Calendar      *BusinessCalendar
+}
+
+// A PayPeriod is a period of a PaySchedule and the date on which it is paid.
+type PayPeriod struct {
+	Period  DateRange
+	PayDate Date
+}
+
+// PeriodOf returns the pay period containing d.
+func (s PaySchedule) PeriodOf(d Date) PayPeriod {
+	var start Date
+	switch s.Frequency {
+	case PayWeekly, PayBiweekly:
+		n := s.length()
+		k := d.DaysSince(s.Anchor)
+		if k < 0 {
+			k -= n - 1
+		}
+		start = s.Anchor.AddDays(k / n * n)
+	case PaySemiMonthly:
+		a, b := s.semiMonthlyDays()
+		switch {
+		case d.Day >= b:
+			start = Date{Year: d.Year, Month: d.Month, Day: b}
+		case d.Day >= a:
+			start = Date{Year: d.Year, Month: d.Month, Day: a}
+		default:
+			prev := YearMonth{Year: d.Year, Month: d.Month}.FirstDate().AddMonths(-1)
+			start = Date{Year: prev.Year, Month: prev.Month, Day: b}
+		}
+	default:
+		start = Date{Year: d.Year, Month: d.Month, Day: 1}
+	}
+	return s.period(start)
+}
+
+// Periods returns an iterator over the pay periods, starting with the one
+// containing from and continuing until MaxDate.
+func (s PaySchedule) Periods(from Date) iter.Seq[PayPeriod] {
+	return func(yield func(PayPeriod) bool) {
+		for p := s.PeriodOf(from); !p.Period.Start.After(MaxDate); p = s.period(p.Period.End.AddDays(1)) {
+			if !yield(p) {
+				return
+			}
+		}
+	}
+}
+
+// period returns the pay period starting on start.
+func (s PaySchedule) period(start Date) PayPeriod {
+	var next Date
+	switch s.Frequency {
+	case PayWeekly, PayBiweekly:
+		next = start.AddDays(s.length())
+	case PaySemiMonthly:
+		a, b := s.semiMonthlyDays()
+		if start.Day < b {
+			next = Date{Year: start.Year, Month: start.Month, Day: b}
+		} else {
+			first := YearMonth{Year: start.Year, Month: start.Month}.FirstDate().AddMonths(1)
+			next = Date{Year: first.Year, Month: first.Month, Day: a}
+		}
+	default:
+		next = start.AddMonths(1)
+	}
+	p := PayPeriod{Period: DateRange{Start: start, End: next.AddDays(-1)}}
+	p.PayDate = p.Period.End.AddDays(s.PayLag)
+	if s.AdjustPayDate {
+		for !s.Calendar.IsWorkingDay(p.PayDate) && p.PayDate.After(MinDate) {
+			p.PayDate = p.PayDate.AddDays(-1)
+		}
+	}
+	return p
+}
+
+// length returns the number of days in a weekly or biweekly period.
+func (s PaySchedule) length() int {
+	if s.Frequency == PayBiweekly {
+		return 14
+	}
+	return 7
+}
+
+// semiMonthlyDays returns the days on which semi-monthly periods start. It
+// panics if they are not valid.
+func (s PaySchedule) semiMonthlyDays() (int, int) {
+	if s.SemiMonthlyDays == [2]int{} {
+		return 1, 16
+	}
+	a, b := s.SemiMonthlyDays[0], s.SemiMonthlyDays[1]
+	if a < 1 || a >= b || b > 28 {
+		panic(fmt.Sprintf("civil: invalid semi-monthly pay days %v", s.SemiMonthlyDays))
+	}
+	return a, b
+}
@@ -0,0 +1,123 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestPayScheduleOf(t *testing.T) {
+	r := func(y1 int, m1 time.Month, d1 int, y2 int, m2 time.Month, d2 int) DateRange {
+		return DateRange{Date{y1, m1, d1}, Date{y2, m2, d2}}
+	}
+	for _, test := range []struct {
+		name string
+		s    PaySchedule
+		d    Date
+		want PayPeriod
+	}{
+		{"monthly", PaySchedule{Frequency: PayMonthly}, Date{2024, 2, 10},
+			PayPeriod{r(2024, 2, 1, 2024, 2, 29), Date{2024, 2, 29}}},
+		{"monthly adjusted", PaySchedule{Frequency: PayMonthly, AdjustPayDate: true}, Date{2024, 3, 10},
+			PayPeriod{r(2024, 3, 1, 2024, 3, 31), Date{2024, 3, 29}}},
+		{"monthly adjusted for a holiday", PaySchedule{Frequency: PayMonthly, AdjustPayDate: true, Calendar: &BusinessCalendar{Holidays: []Date{{2024, 12, 31}}}}, Date{2024, 12, 1},
+			PayPeriod{r(2024, 12, 1, 2024, 12, 31), Date{2024, 12, 30}}},
+		{"semi-monthly first half", PaySchedule{Frequency: PaySemiMonthly}, Date{2024, 2, 5},
+			PayPeriod{r(2024, 2, 1, 2024, 2, 15), Date{2024, 2, 15}}},
+		{"semi-monthly second half", PaySchedule{Frequency: PaySemiMonthly}, Date{2024, 2, 16},
+			PayPeriod{r(2024, 2, 16, 2024, 2, 29), Date{2024, 2, 29}}},
+		{"semi-monthly custom days", PaySchedule{Frequency: PaySemiMonthly, SemiMonthlyDays: [2]int{5, 20}}, Date{2024, 1, 3},
+			PayPeriod{r(2023, 12, 20, 2024, 1, 4), Date{2024, 1, 4}}},
+		{"weekly", PaySchedule{Frequency: PayWeekly, Anchor: Date{2024, 1, 1}}, Date{2024, 1, 10},
+			PayPeriod{r(2024, 1, 8, 2024, 1, 14), Date{2024, 1, 14}}},
+		{"biweekly before the anchor", PaySchedule{Frequency: PayBiweekly, Anchor: Date{2024, 1, 5}}, Date{2024, 1, 4},
+			PayPeriod{r(2023, 12, 22, 2024, 1, 4), Date{2024, 1, 4}}},
+		{"biweekly with a lag", PaySchedule{Frequency: PayBiweekly, Anchor: Date{2024, 1, 5}, PayLag: 5}, Date{2024, 1, 19},
+			PayPeriod{r(2024, 1, 19, 2024, 2, 1), Date{2024, 2, 6}}},
+		{"biweekly far before the anchor", PaySchedule{Frequency: PayBiweekly, Anchor: Date{2024, 1, 5}}, Date{2023, 1, 6},
+			PayPeriod{r(2023, 1, 6, 2023, 1, 19), Date{2023, 1, 19}}},
+	} {
+		if got := test.s.PeriodOf(test.d); got != test.want {
+			t.Errorf("%s: PeriodOf(%v) = %v, want %v", test.name, test.d, got, test.want)
+		}
+	}
+}
+
+func TestPaySchedulePeriods(t *testing.T) {
+	for _, s := range []PaySchedule{
+		{Frequency: PayMonthly},
+		{Frequency: PaySemiMonthly},
+		{Frequency: PaySemiMonthly, SemiMonthlyDays: [2]int{10, 25}},
+		{Frequency: PayWeekly, Anchor: Date{2024, 1, 3}},
+		{Frequency: PayBiweekly, Anchor: Date{2024, 1, 3}},
+	} {
+		from := Date{2024, 1, 20}
+		var prev PayPeriod
+		n := 0
+		for p := range s.Periods(from) {
+			if n == 0 && !p.Period.Contains(from) {
+				t.Errorf("%v: first period %v does not contain %v", s.Frequency, p.Period, from)
+			}
+			if n > 0 && p.Period.Start != prev.Period.End.AddDays(1) {
+				t.Errorf("%v: period %v does not follow %v", s.Frequency, p.Period, prev.Period)
+			}
+			if got := s.PeriodOf(p.Period.End); got != p {
+				t.Errorf("%v: PeriodOf(%v) = %v, want %v", s.Frequency, p.Period.End, got, p)
+			}
+			prev = p
+			if n++; n == 30 {
+				break
+			}
+		}
+	}
+	if got := len(slices.Collect(PaySchedule{Frequency: PayMonthly}.Periods(Date{9999, 10, 15}))); got != 3 {
+		t.Errorf("Periods near MaxDate yielded %d periods, want 3", got)
+	}
+}
+
+func TestPayFrequencyString(t *testing.T) {
+	for f, want := range map[PayFrequency]string{
+		PayMonthly:      "PayMonthly",
+		PaySemiMonthly:  "PaySemiMonthly",
+		PayWeekly:       "PayWeekly",
+		PayBiweekly:     "PayBiweekly",
+		PayFrequency(4): "PayFrequency(4)",
+	} {
+		if got := f.String(); got != want {
+			t.Errorf("PayFrequency(%d).String() = %q, want %q", int(f), got, want)
+		}
+	}
+}
+
+func TestPayScheduleInvalidSemiMonthlyDays(t *testing.T) {
+	for _, days := range [][2]int{{16, 0}, {20, 10}, {0, 40}, {10, 10}, {1, 29}, {-1, 16}} {
+		s := PaySchedule{Frequency: PaySemiMonthly, SemiMonthlyDays: days}
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("PeriodOf with SemiMonthlyDays %v did not panic", days)
+				}
+			}()
+			s.PeriodOf(Date{2024, 1, 15})
+		}()
+	}
+	// The days are not used by other frequencies.
+	s := PaySchedule{Frequency: PayMonthly, SemiMonthlyDays: [2]int{20, 10}}
+	if got, want := s.PeriodOf(Date{2024, 1, 15}).Period, (DateRange{Date{2024, 1, 1}, Date{2024, 1, 31}}); got != want {
+		t.Errorf("monthly PeriodOf = %v, want %v", got, want)
+	}
+}
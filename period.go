@@ -0,0 +1,260 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// A Period represents an amount of calendar time in years, months, weeks
+// and days. Unlike a time.Duration, the length of a Period in days depends
+// on the date to which it is added.
+type Period struct {
+	Years  int
+	Months int
+	Weeks  int
+	Days   int
+}
+
+// AddPeriod returns the date that is the period p after d. The years and
+// months of p are added first, as by AddMonths, and then its weeks and days.
+func (d Date) AddPeriod(p Period) Date {
+	return d.AddMonths(12*p.Years + p.Months).AddDays(7*p.Weeks + p.Days)
+}
+
+// AddPeriod returns the datetime that is the period p after dt. The time of
+// day is unchanged.
+func (dt DateTime) AddPeriod(p Period) DateTime {
+	dt.Date = dt.Date.AddPeriod(p)
+	return dt
+}
+
+// AddPeriodDuration returns the datetime that is the period p and then the
+// duration d after dt, as java.time does for a Period followed by a
+// Duration. The calendar part is added first, as by AddPeriod, keeping the
+// time of day; then d is added to the wall clock, as by Add. So one month
+// and two hours after 2024-01-31T23:00 is 2024-03-03T01:00: February 31
+// normalizes to March 2, and the hours then carry past midnight. Adding
+// the hours first would give 2024-03-01T01:00 instead. dt.Time must be
+// valid.
+func (dt DateTime) AddPeriodDuration(p Period, d time.Duration) DateTime {
+	return dt.AddPeriod(p).Add(d)
+}
+
+// Sub returns the period from s to d in years, months and days, such that
+// s.AddPeriod(d.Sub(s)) == d. Use DaysSince for the difference in days.
+//
+// The months are counted first: the result has the largest number of whole
+// months that can be added to s without passing d, and then the remaining
+// days. So from January 31 to March 1, 2024, the period is 30 days, since
+// January 31 plus one month is March 2. If d is before s, the months are
+// counted backwards from s in the same way, and every field of the result
+// is negative or zero: from 2023-03-30 back to 2023-01-31 is P-1M-30D,
+// since two months back would pass January 31. The Weeks field of the
+// result is always zero.
+func (d Date) Sub(s Date) Period {
+	return periodBetween(s, d)
+}
+
+// MonthsBetween returns the number of whole months from a to b: the largest
+// n such that a.AddMonths(n) does not pass b, which is the number of years
+// and months of b.Sub(a) expressed in months. Since AddMonths normalizes,
+// there are no whole months from January 31 to February 29, 2024. If b is
+// before a, the result is the negation of MonthsBetween(b, a), so the count
+// always truncates towards zero.
+func MonthsBetween(a, b Date) int {
+	if b.Before(a) {
+		return -MonthsBetween(b, a)
+	}
+	p := periodBetween(a, b)
+	return 12*p.Years + p.Months
+}
+
+// WeeksBetween returns the number of whole weeks from a to b, truncated
+// towards zero: b.DaysSince(a) / 7.
+func WeeksBetween(a, b Date) int {
+	return b.DaysSince(a) / 7
+}
+
+// ParsePeriod parses an ISO 8601 duration of the form "PnYnMnWnD", such as
+// "P1Y2M3D" or "P2W". Components may be omitted, but at least one must be
+// present, and those present must appear in that order. A component may be
+// negative, as in "P1M-1D", and a leading '-' negates the whole period.
+//
+// A duration with a time part, such as "P1DT12H", is an error; use ParseSpan
+// to parse one.
+func ParsePeriod(s string) (Period, error) {
+	sp, err := parseISODuration(s, false)
+	return sp.Period, err
+}
+
+// String returns the period as an ISO 8601 duration, such as "P1Y2M3D".
+// Zero components are omitted; the zero Period is "P0D".
+func (p Period) String() string {
+	if p == (Period{}) {
+		return "P0D"
+	}
+	return string(p.appendISO([]byte{'P'}))
+}
+
+// appendISO appends the nonzero components of p to b in ISO 8601 form,
+// without the leading 'P'.
+func (p Period) appendISO(b []byte) []byte {
+	for _, c := range []struct {
+		n    int
+		unit byte
+	}{{p.Years, 'Y'}, {p.Months, 'M'}, {p.Weeks, 'W'}, {p.Days, 'D'}} {
+		if c.n != 0 {
+			b = strconv.AppendInt(b, int64(c.n), 10)
+			b = append(b, c.unit)
+		}
+	}
+	return b
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// The output is the result of p.String().
+func (p Period) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// The period is expected to be a string in a format accepted by ParsePeriod.
+func (p *Period) UnmarshalText(data []byte) error {
+	var err error
+	*p, err = ParsePeriod(string(data))
+	return err
+}
+
+// parseISODuration parses an ISO 8601 duration into its calendar and clock
+// parts. The clock part, introduced by 'T', is permitted only if allowTime is
+// set. Only the seconds may have a fraction, separated by '.' or ','.
+func parseISODuration(s string, allowTime bool) (Span, error) {
+	bad := fmt.Errorf("civil: invalid ISO 8601 duration %q", s)
+	rest, neg := strings.CutPrefix(s, "-")
+	rest, ok := strings.CutPrefix(rest, "P")
+	if !ok || rest == "" {
+		return Span{}, bad
+	}
+	date, clock, hasTime := strings.Cut(rest, "T")
+	if hasTime && !allowTime {
+		return Span{}, fmt.Errorf("civil: ISO 8601 duration %q has a time part", s)
+	}
+	if hasTime && clock == "" {
+		return Span{}, bad
+	}
+	var sp Span
+	units := "YMWD"
+	for date != "" {
+		num, unit, rest, ok := cutDurationComponent(date, false)
+		i := strings.IndexByte(units, unit)
+		n, err := strconv.Atoi(num)
+		if !ok || i < 0 || err != nil {
+			return Span{}, bad
+		}
+		switch unit {
+		case 'Y':
+			sp.Years = n
+		case 'M':
+			sp.Months = n
+		case 'W':
+			sp.Weeks = n
+		case 'D':
+			sp.Days = n
+		}
+		units, date = units[i+1:], rest
+	}
+	units = "HMS"
+	for clock != "" {
+		num, unit, rest, ok := cutDurationComponent(clock, true)
+		i := strings.IndexByte(units, unit)
+		if !ok || i < 0 || (unit != 'S' && strings.ContainsAny(num, ".,")) {
+			return Span{}, bad
+		}
+		d, err := time.ParseDuration(strings.Replace(num, ",", ".", 1) + strings.ToLower(string(unit)))
+		sum := sp.Duration + d
+		if err != nil || (d > 0 && sum < sp.Duration) || (d < 0 && sum > sp.Duration) {
+			return Span{}, bad
+		}
+		sp.Duration = sum
+		units, clock = units[i+1:], rest
+	}
+	if neg {
+		sp = Span{Period: sp.Period.neg(), Duration: -sp.Duration}
+	}
+	return sp, nil
+}
+
+// cutDurationComponent splits the leading component, such as "-12D", from
+// s. The number may have a fraction only if frac is set, and then must have
+// digits on both sides of the decimal sign.
+func cutDurationComponent(s string, frac bool) (num string, unit byte, rest string, ok bool) {
+	i := 0
+	if i < len(s) && (s[i] == '-' || s[i] == '+') {
+		i++
+	}
+	start, digits := i, 0
+	for i < len(s) && (isDigit(s[i]) || (frac && (s[i] == '.' || s[i] == ','))) {
+		if isDigit(s[i]) {
+			digits++
+		}
+		i++
+	}
+	if digits == 0 || i == len(s) || !isDigit(s[start]) || !isDigit(s[i-1]) {
+		return "", 0, "", false
+	}
+	return s[:i], s[i], s[i+1:], true
+}
+
+// mul returns the period with each field multiplied by n.
+func (p Period) mul(n int) Period {
+	return Period{Years: n * p.Years, Months: n * p.Months, Weeks: n * p.Weeks, Days: n * p.Days}
+}
+
+// neg returns the period with the sign of each field reversed.
+func (p Period) neg() Period {
+	return Period{Years: -p.Years, Months: -p.Months, Weeks: -p.Weeks, Days: -p.Days}
+}
+
+// periodBetween returns the period from a to b in years, months and days,
+// such that a.AddPeriod(periodBetween(a, b)) == b. It counts the largest
+// number of whole months that can be added to a without passing b, and then
+// the remaining days. If b is before a, the months are counted backwards
+// from a in the same way, and all the fields are negative or zero.
+func periodBetween(a, b Date) Period {
+	months := b.monthIndex() - a.monthIndex()
+	// AddMonths normalizes a day of the month past the end of the target
+	// month into the following month, so more than one step towards a may
+	// be needed.
+	switch {
+	case months > 0:
+		for months > 0 && a.AddMonths(months).After(b) {
+			months--
+		}
+	case months < 0:
+		for months < 0 && a.AddMonths(months).Before(b) {
+			months++
+		}
+	}
+	days := b.DaysSince(a.AddMonths(months))
+	return Period{
+		Years:  months / 12,
+		Months: months % 12,
+		Days:   days,
+	}
+}
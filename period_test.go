@@ -0,0 +1,217 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDateSub(t *testing.T) {
+	for _, test := range []struct {
+		s, d Date
+		want Period
+	}{
+		{Date{2024, 1, 1}, Date{2024, 1, 1}, Period{}},
+		{Date{2024, 1, 15}, Date{2025, 3, 20}, Period{Years: 1, Months: 2, Days: 5}},
+		{Date{2024, 1, 31}, Date{2024, 3, 1}, Period{Days: 30}},
+		{Date{2024, 1, 31}, Date{2024, 3, 2}, Period{Months: 1}},
+		{Date{2023, 1, 31}, Date{2023, 3, 1}, Period{Days: 29}},
+		{Date{2023, 1, 31}, Date{2023, 3, 30}, Period{Months: 1, Days: 27}},
+		{Date{2024, 2, 29}, Date{2025, 2, 28}, Period{Months: 11, Days: 30}},
+		{Date{2024, 3, 20}, Date{2024, 1, 15}, Period{Months: -2, Days: -5}},
+		// Counting back from an end-of-month date.
+		{Date{2023, 3, 30}, Date{2023, 1, 31}, Period{Months: -1, Days: -30}},
+		{Date{2024, 3, 31}, Date{2024, 2, 29}, Period{Months: -1, Days: -2}},
+		{Date{2024, 3, 31}, Date{2023, 2, 28}, Period{Years: -1, Months: -1, Days: -3}},
+	} {
+		got := test.d.Sub(test.s)
+		if got != test.want {
+			t.Errorf("%v.Sub(%v) = %v, want %v", test.d, test.s, got, test.want)
+		}
+		if sum := test.s.AddPeriod(got); sum != test.d {
+			t.Errorf("%v.AddPeriod(%v) = %v, want %v", test.s, got, sum, test.d)
+		}
+	}
+}
+
+func TestDateSubInvariant(t *testing.T) {
+	// Every pair of dates over a leap year and its neighbours.
+	start := Date{2023, time.November, 1}
+	for i := 0; i < 500; i++ {
+		s := start.AddDays(i)
+		for j := 0; j < 500; j++ {
+			d := start.AddDays(j)
+			p := d.Sub(s)
+			if got := s.AddPeriod(p); got != d {
+				t.Fatalf("%v.AddPeriod(%v.Sub(%v)) = %v", s, d, s, got)
+			}
+			if (p.Years < 0 || p.Months < 0 || p.Days < 0) && (p.Years > 0 || p.Months > 0 || p.Days > 0) {
+				t.Fatalf("%v.Sub(%v) = %v has mixed signs", d, s, p)
+			}
+		}
+	}
+}
+
+func TestMonthsBetween(t *testing.T) {
+	for _, test := range []struct {
+		a, b Date
+		want int
+	}{
+		{Date{2024, 1, 15}, Date{2024, 1, 31}, 0},
+		{Date{2024, 1, 15}, Date{2024, 2, 15}, 1},
+		{Date{2024, 1, 31}, Date{2024, 2, 29}, 0},
+		{Date{2024, 1, 31}, Date{2024, 3, 2}, 1},
+		{Date{2022, 6, 30}, Date{2024, 7, 1}, 24},
+		{Date{2024, 2, 15}, Date{2024, 1, 15}, -1},
+		{Date{2024, 2, 29}, Date{2024, 1, 31}, 0},
+	} {
+		if got := MonthsBetween(test.a, test.b); got != test.want {
+			t.Errorf("MonthsBetween(%v, %v) = %d, want %d", test.a, test.b, got, test.want)
+		}
+	}
+}
+
+func TestWeeksBetween(t *testing.T) {
+	for _, test := range []struct {
+		a, b Date
+		want int
+	}{
+		{Date{2024, 1, 1}, Date{2024, 1, 7}, 0},
+		{Date{2024, 1, 1}, Date{2024, 1, 8}, 1},
+		{Date{2024, 1, 8}, Date{2024, 1, 2}, 0},
+		{Date{2024, 1, 15}, Date{2024, 1, 1}, -2},
+	} {
+		if got := WeeksBetween(test.a, test.b); got != test.want {
+			t.Errorf("WeeksBetween(%v, %v) = %d, want %d", test.a, test.b, got, test.want)
+		}
+	}
+}
+
+func TestAddPeriod(t *testing.T) {
+	for _, test := range []struct {
+		d    Date
+		p    Period
+		want Date
+	}{
+		{Date{2024, 1, 31}, Period{}, Date{2024, 1, 31}},
+		{Date{2024, 1, 31}, Period{Months: 1}, Date{2024, 3, 2}},
+		{Date{2024, 1, 31}, Period{Months: 1, Days: -1}, Date{2024, 3, 1}},
+		{Date{2024, 2, 29}, Period{Years: 1}, Date{2025, 3, 1}},
+		{Date{2024, 2, 29}, Period{Years: 1, Months: -1}, Date{2025, 1, 29}},
+		{Date{2024, 3, 10}, Period{Weeks: 2, Days: 3}, Date{2024, 3, 27}},
+		{Date{2024, 3, 10}, Period{Years: -1, Months: -2, Weeks: -1, Days: -1}, Date{2023, 1, 2}},
+	} {
+		if got := test.d.AddPeriod(test.p); got != test.want {
+			t.Errorf("%v.AddPeriod(%+v) = %v, want %v", test.d, test.p, got, test.want)
+		}
+		dt := DateTime{test.d, Time{13, 14, 15, 0}}
+		if got, want := dt.AddPeriod(test.p), (DateTime{test.want, dt.Time}); got != want {
+			t.Errorf("%v.AddPeriod(%+v) = %v, want %v", dt, test.p, got, want)
+		}
+	}
+}
+
+func TestAddPeriodDuration(t *testing.T) {
+	dt := func(y int, mo time.Month, d, h int) DateTime { return DateTime{Date{y, mo, d}, Time{Hour: h}} }
+	for _, test := range []struct {
+		dt   DateTime
+		p    Period
+		d    time.Duration
+		want DateTime
+	}{
+		// February 31 normalizes to March 2 before the hours carry.
+		{dt(2024, 1, 31, 23), Period{Months: 1}, 2 * time.Hour, dt(2024, 3, 3, 1)},
+		{dt(2024, 1, 31, 23), Period{}, 2 * time.Hour, dt(2024, 2, 1, 1)},
+		{dt(2024, 1, 31, 23), Period{Months: 1}, 0, dt(2024, 3, 2, 23)},
+		{dt(2024, 2, 29, 1), Period{Years: 1}, -2 * time.Hour, dt(2025, 2, 28, 23)},
+		{dt(2024, 3, 10, 12), Period{Weeks: 1, Days: -1}, 36 * time.Hour, dt(2024, 3, 18, 0)},
+	} {
+		if got := test.dt.AddPeriodDuration(test.p, test.d); got != test.want {
+			t.Errorf("%v.AddPeriodDuration(%+v, %v) = %v, want %v", test.dt, test.p, test.d, got, test.want)
+		}
+		if got := (Span{Period: test.p, Duration: test.d}).AddTo(test.dt); got != test.want {
+			t.Errorf("Span{%+v, %v}.AddTo(%v) = %v, want %v", test.p, test.d, test.dt, got, test.want)
+		}
+	}
+}
+
+func TestParsePeriod(t *testing.T) {
+	for _, test := range []struct {
+		s    string
+		want Period
+		str  string
+	}{
+		{"P1Y2M3D", Period{Years: 1, Months: 2, Days: 3}, "P1Y2M3D"},
+		{"P2W", Period{Weeks: 2}, "P2W"},
+		{"P1Y2M3W4D", Period{1, 2, 3, 4}, "P1Y2M3W4D"},
+		{"P0D", Period{}, "P0D"},
+		{"P0Y0M", Period{}, "P0D"},
+		{"P1M-1D", Period{Months: 1, Days: -1}, "P1M-1D"},
+		{"-P1Y2M", Period{Years: -1, Months: -2}, "P-1Y-2M"},
+		{"-P-3D", Period{Days: 3}, "P3D"},
+		{"P+5D", Period{Days: 5}, "P5D"},
+	} {
+		got, err := ParsePeriod(test.s)
+		if err != nil || got != test.want {
+			t.Errorf("ParsePeriod(%q) = %+v, %v, want %+v", test.s, got, err, test.want)
+			continue
+		}
+		if s := got.String(); s != test.str {
+			t.Errorf("%+v.String() = %q, want %q", got, s, test.str)
+		}
+		if back, err := ParsePeriod(got.String()); err != nil || back != got {
+			t.Errorf("ParsePeriod(%q) = %+v, %v, want %+v", got.String(), back, err, got)
+		}
+	}
+}
+
+func TestParsePeriodRejects(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"P",
+		"-P",
+		"1Y",
+		"p1Y",
+		"P1",
+		"PY",
+		"P1D2M",
+		"P1Y1Y",
+		"P1.5D",
+		"P1DT12H",
+		"P1H",
+		"P--1D",
+		"P1Y 2M",
+	} {
+		if got, err := ParsePeriod(s); err == nil {
+			t.Errorf("ParsePeriod(%q) = %+v, want error", s, got)
+		}
+	}
+}
+
+func TestPeriodText(t *testing.T) {
+	p := Period{Years: 1, Days: 1}
+	data, err := p.MarshalText()
+	if err != nil || string(data) != "P1Y1D" {
+		t.Errorf("%+v.MarshalText() = %q, %v, want P1Y1D", p, data, err)
+	}
+	var got Period
+	if err := got.UnmarshalText(data); err != nil || got != p {
+		t.Errorf("UnmarshalText(%q) = %+v, %v, want %+v", data, got, err, p)
+	}
+	if err := got.UnmarshalText([]byte("P1X")); err == nil {
+		t.Error("UnmarshalText(P1X) succeeded, want error")
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+// The methods in this file prorate an amount over the calendar period
+// containing a date. They count whole days, splitting the period at the
+// start of the date: the days before it have elapsed, and the date itself
+// and the days after it remain. The two fractions therefore sum to 1, and
+// a subscription that starts on a date is charged its remaining fraction
+// of the period.
+
+// PeriodContaining returns the week, month, quarter or year containing d,
+// with the boundaries defined by Truncate. It panics if u is not a known
+// Unit.
+func (d Date) PeriodContaining(u Unit) DateRange {
+	start := d.Truncate(u)
+	var end Date
+	switch u {
+	case Weeks:
+		end = start.AddDays(6)
+	case Months:
+		end = d.EndOfMonth()
+	case Quarters:
+		end = d.EndOfQuarter()
+	case Years:
+		end = d.EndOfYear()
+	}
+	return DateRange{Start: start, End: end}
+}
+
+// FractionElapsed returns the fraction of the days of the period of unit u
+// containing d that fall before d. It is 0 on the first day of the period.
+// For example, on 2024-02-11 the elapsed fraction of the month is 10/29.
+func (d Date) FractionElapsed(u Unit) float64 {
+	r := d.PeriodContaining(u)
+	return float64(d.DaysSince(r.Start)) / float64(r.Days())
+}
+
+// FractionRemaining returns the fraction of the days of the period of unit
+// u containing d that fall on or after d. It is 1 on the first day of the
+// period, and 1/n on the last day of a period of n days.
+func (d Date) FractionRemaining(u Unit) float64 {
+	r := d.PeriodContaining(u)
+	return float64(r.End.DaysSince(d)+1) / float64(r.Days())
+}
@@ -0,0 +1,55 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import "testing"
+
+func TestProrate(t *testing.T) {
+	for _, test := range []struct {
+		d       Date
+		u       Unit
+		period  DateRange
+		elapsed int
+	}{
+		{Date{2024, 2, 11}, Months, DateRange{Date{2024, 2, 1}, Date{2024, 2, 29}}, 10},
+		{Date{2024, 2, 1}, Months, DateRange{Date{2024, 2, 1}, Date{2024, 2, 29}}, 0},
+		{Date{2023, 2, 28}, Months, DateRange{Date{2023, 2, 1}, Date{2023, 2, 28}}, 27},
+		// 2024-03-13 is a Wednesday; weeks start on Monday.
+		{Date{2024, 3, 13}, Weeks, DateRange{Date{2024, 3, 11}, Date{2024, 3, 17}}, 2},
+		{Date{2024, 5, 15}, Quarters, DateRange{Date{2024, 4, 1}, Date{2024, 6, 30}}, 44},
+		{Date{2024, 12, 31}, Years, DateRange{Date{2024, 1, 1}, Date{2024, 12, 31}}, 365},
+		{Date{2023, 7, 2}, Years, DateRange{Date{2023, 1, 1}, Date{2023, 12, 31}}, 182},
+	} {
+		if got := test.d.PeriodContaining(test.u); got != test.period {
+			t.Errorf("%v.PeriodContaining(%v) = %v, want %v", test.d, test.u, got, test.period)
+		}
+		n := test.period.Days()
+		if got, want := test.d.FractionElapsed(test.u), float64(test.elapsed)/float64(n); got != want {
+			t.Errorf("%v.FractionElapsed(%v) = %v, want %v", test.d, test.u, got, want)
+		}
+		if got, want := test.d.FractionRemaining(test.u), float64(n-test.elapsed)/float64(n); got != want {
+			t.Errorf("%v.FractionRemaining(%v) = %v, want %v", test.d, test.u, got, want)
+		}
+	}
+}
+
+func TestPeriodContainingPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("PeriodContaining with an unknown unit did not panic")
+		}
+	}()
+	Date{2024, 1, 1}.PeriodContaining(Unit(99))
+}
@@ -0,0 +1,89 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// A Quarter represents a quarter of a particular year. The first quarter
+// runs from January to March.
+type Quarter struct {
+	Year    int // Year (e.g., 2014).
+	Quarter int // Quarter of the year; range [1-4]
+}
+
+// ParseQuarter parses a string in the format YYYY-QN, as in "2024-Q1", and
+// returns the quarter it represents.
+func ParseQuarter(s string) (Quarter, error) {
+	if len(s) != 7 || s[4] != '-' || s[5] != 'Q' || s[6] < '1' || s[6] > '4' || !isDigits(s[:4]) {
+		return Quarter{}, fmt.Errorf("civil: cannot parse %q as a quarter", s)
+	}
+	y, _ := strconv.Atoi(s[:4])
+	return Quarter{Year: y, Quarter: int(s[6] - '0')}, nil
+}
+
+// String returns the quarter in the format YYYY-QN.
+func (q Quarter) String() string {
+	return fmt.Sprintf("%04d-Q%d", q.Year, q.Quarter)
+}
+
+// IsValid reports whether the quarter is valid.
+func (q Quarter) IsValid() bool {
+	return 1 <= q.Quarter && q.Quarter <= 4
+}
+
+// FirstDate returns the first day of the quarter.
+func (q Quarter) FirstDate() Date {
+	return Date{Year: q.Year, Month: time.Month(3*q.Quarter - 2), Day: 1}
+}
+
+// LastDate returns the last day of the quarter.
+func (q Quarter) LastDate() Date {
+	return q.FirstDate().AddMonths(3).AddDays(-1)
+}
+
+// AddQuarters returns the quarter that is n quarters after q. n may be
+// negative.
+func (q Quarter) AddQuarters(n int) Quarter {
+	i := q.Year*4 + q.Quarter - 1 + n
+	y := i / 4
+	if i%4 < 0 {
+		y--
+	}
+	return Quarter{Year: y, Quarter: i - 4*y + 1}
+}
+
+// Contains reports whether d falls in the quarter.
+func (q Quarter) Contains(d Date) bool {
+	return d.Quarter() == q
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// The output is the result of q.String().
+func (q Quarter) MarshalText() ([]byte, error) {
+	return []byte(q.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// The quarter is expected to be a string in a format accepted by
+// ParseQuarter.
+func (q *Quarter) UnmarshalText(data []byte) error {
+	var err error
+	*q, err = ParseQuarter(string(data))
+	return err
+}
@@ -0,0 +1,114 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import "testing"
+
+func TestQuarter(t *testing.T) {
+	for _, test := range []struct {
+		q           Quarter
+		str         string
+		first, last Date
+	}{
+		{Quarter{2024, 1}, "2024-Q1", Date{2024, 1, 1}, Date{2024, 3, 31}},
+		{Quarter{2024, 2}, "2024-Q2", Date{2024, 4, 1}, Date{2024, 6, 30}},
+		{Quarter{2024, 3}, "2024-Q3", Date{2024, 7, 1}, Date{2024, 9, 30}},
+		{Quarter{2024, 4}, "2024-Q4", Date{2024, 10, 1}, Date{2024, 12, 31}},
+	} {
+		if got := test.q.String(); got != test.str {
+			t.Errorf("%#v.String() = %q, want %q", test.q, got, test.str)
+		}
+		if got := test.q.FirstDate(); got != test.first {
+			t.Errorf("%v.FirstDate() = %v, want %v", test.q, got, test.first)
+		}
+		if got := test.q.LastDate(); got != test.last {
+			t.Errorf("%v.LastDate() = %v, want %v", test.q, got, test.last)
+		}
+		if !test.q.IsValid() {
+			t.Errorf("%v.IsValid() = false", test.q)
+		}
+		for _, d := range []Date{test.first, test.last} {
+			if got := d.Quarter(); got != test.q {
+				t.Errorf("%v.Quarter() = %v, want %v", d, got, test.q)
+			}
+		}
+	}
+	for _, q := range []Quarter{{2024, 0}, {2024, 5}} {
+		if q.IsValid() {
+			t.Errorf("%#v.IsValid() = true", q)
+		}
+	}
+}
+
+func TestParseQuarter(t *testing.T) {
+	for _, s := range []string{"2024-Q1", "2024-Q4", "0001-Q2", "9999-Q3"} {
+		q, err := ParseQuarter(s)
+		if err != nil {
+			t.Errorf("ParseQuarter(%q): %v", s, err)
+			continue
+		}
+		if got := q.String(); got != s {
+			t.Errorf("ParseQuarter(%q).String() = %q", s, got)
+		}
+		var u Quarter
+		if err := u.UnmarshalText([]byte(s)); err != nil || u != q {
+			t.Errorf("UnmarshalText(%q) = %v, %v, want %v", s, u, err, q)
+		}
+	}
+	for _, s := range []string{"", "2024-Q0", "2024-Q5", "2024Q1", "2024-q1", "24-Q1", "2024-Q12", "+024-Q1", "2024-01"} {
+		if q, err := ParseQuarter(s); err == nil {
+			t.Errorf("ParseQuarter(%q) = %v, want error", s, q)
+		}
+	}
+}
+
+func TestAddQuarters(t *testing.T) {
+	for _, test := range []struct {
+		q    Quarter
+		n    int
+		want Quarter
+	}{
+		{Quarter{2024, 1}, 0, Quarter{2024, 1}},
+		{Quarter{2024, 1}, 3, Quarter{2024, 4}},
+		{Quarter{2024, 4}, 1, Quarter{2025, 1}},
+		{Quarter{2024, 1}, -1, Quarter{2023, 4}},
+		{Quarter{2024, 2}, -9, Quarter{2022, 1}},
+		{Quarter{2024, 3}, 10, Quarter{2027, 1}},
+		{Quarter{0, 1}, -1, Quarter{-1, 4}},
+		{Quarter{-1, 4}, 1, Quarter{0, 1}},
+	} {
+		if got := test.q.AddQuarters(test.n); got != test.want {
+			t.Errorf("%v.AddQuarters(%d) = %v, want %v", test.q, test.n, got, test.want)
+		}
+	}
+}
+
+func TestQuarterContains(t *testing.T) {
+	q := Quarter{2024, 2}
+	for _, test := range []struct {
+		d    Date
+		want bool
+	}{
+		{Date{2024, 3, 31}, false},
+		{Date{2024, 4, 1}, true},
+		{Date{2024, 6, 30}, true},
+		{Date{2024, 7, 1}, false},
+		{Date{2023, 5, 1}, false},
+	} {
+		if got := q.Contains(test.d); got != test.want {
+			t.Errorf("%v.Contains(%v) = %t, want %t", q, test.d, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import "slices"
+
+// A DateRangeIndex answers overlap queries over a fixed set of date ranges
+// in O(log n + k) time for k results. Build one with NewDateRangeIndex.
+type DateRangeIndex struct {
+	t intervalTree[Date]
+}
+
+// NewDateRangeIndex returns an index of rs. Queries return positions in rs.
+// Ranges whose Start is after their End are never returned. The index does
+// not retain rs, which may be modified afterwards.
+func NewDateRangeIndex(rs []DateRange) *DateRangeIndex {
+	x := &DateRangeIndex{}
+	for i, r := range rs {
+		if r.Start.After(r.End) {
+			continue
+		}
+		x.t.nodes = append(x.t.nodes, intervalNode[Date]{start: r.Start, end: r.End, index: i})
+	}
+	x.t.build()
+	return x
+}
+
+// Query returns the positions of the ranges that contain d, in ascending
+// order.
+func (x *DateRangeIndex) Query(d Date) []int {
+	return x.QueryRange(DateRange{Start: d, End: d})
+}
+
+// QueryRange returns the positions of the ranges that overlap r, in
+// ascending order.
+func (x *DateRangeIndex) QueryRange(r DateRange) []int {
+	return x.t.query(
+		func(start Date) bool { return !start.After(r.End) },
+		func(end Date) bool { return !end.Before(r.Start) },
+	)
+}
+
+// A DateTimeRangeIndex answers overlap queries over a fixed set of datetime
+// ranges in O(log n + k) time for k results. Build one with
+// NewDateTimeRangeIndex.
+type DateTimeRangeIndex struct {
+	t intervalTree[DateTime]
+}
+
+// NewDateTimeRangeIndex returns an index of rs. Queries return positions in
+// rs. Empty ranges are never returned. The index does not retain rs, which
+// may be modified afterwards.
+func NewDateTimeRangeIndex(rs []DateTimeRange) *DateTimeRangeIndex {
+	x := &DateTimeRangeIndex{}
+	for i, r := range rs {
+		if r.IsEmpty() {
+			continue
+		}
+		x.t.nodes = append(x.t.nodes, intervalNode[DateTime]{start: r.Start, end: r.End, index: i})
+	}
+	x.t.build()
+	return x
+}
+
+// Query returns the positions of the ranges that contain dt, in ascending
+// order.
+func (x *DateTimeRangeIndex) Query(dt DateTime) []int {
+	return x.t.query(
+		func(start DateTime) bool { return !start.After(dt) },
+		func(end DateTime) bool { return end.After(dt) },
+	)
+}
+
+// QueryRange returns the positions of the ranges that overlap r, in
+// ascending order. An empty r overlaps no range, as for
+// DateTimeRange.Overlaps.
+func (x *DateTimeRangeIndex) QueryRange(r DateTimeRange) []int {
+	if r.IsEmpty() {
+		return nil
+	}
+	return x.t.query(
+		func(start DateTime) bool { return start.Before(r.End) },
+		func(end DateTime) bool { return end.After(r.Start) },
+	)
+}
+
+// An intervalTree is a static augmented binary search tree, stored as an
+// array sorted by start. The node at the middle of each subarray is the root
+// of the subtree spanning it, and maxEnd records the latest end within that
+// subtree.
+type intervalTree[T interface{ Compare(T) int }] struct {
+	nodes []intervalNode[T]
+}
+
+type intervalNode[T any] struct {
+	start, end T
+	maxEnd     T
+	index      int
+}
+
+// build sorts the nodes and computes maxEnd for each subtree.
+func (t *intervalTree[T]) build() {
+	slices.SortFunc(t.nodes, func(a, b intervalNode[T]) int { return a.start.Compare(b.start) })
+	if len(t.nodes) > 0 {
+		t.augment(0, len(t.nodes))
+	}
+}
+
+// augment sets maxEnd for the subtree spanning nodes[lo:hi] and returns it.
+func (t *intervalTree[T]) augment(lo, hi int) T {
+	mid := (lo + hi) / 2
+	n := &t.nodes[mid]
+	n.maxEnd = n.end
+	if lo < mid {
+		if e := t.augment(lo, mid); e.Compare(n.maxEnd) > 0 {
+			n.maxEnd = e
+		}
+	}
+	if mid+1 < hi {
+		if e := t.augment(mid+1, hi); e.Compare(n.maxEnd) > 0 {
+			n.maxEnd = e
+		}
+	}
+	return n.maxEnd
+}
+
+// query returns the indexes of the nodes whose start satisfies startOK and
+// whose end satisfies endOK. startOK must hold for every start up to some
+// point, and endOK for every end from some point on.
+func (t *intervalTree[T]) query(startOK, endOK func(T) bool) []int {
+	var r []int
+	var walk func(lo, hi int)
+	walk = func(lo, hi int) {
+		if lo >= hi {
+			return
+		}
+		mid := (lo + hi) / 2
+		n := t.nodes[mid]
+		if !endOK(n.maxEnd) {
+			return
+		}
+		walk(lo, mid)
+		if !startOK(n.start) {
+			return
+		}
+		if endOK(n.end) {
+			r = append(r, n.index)
+		}
+		walk(mid+1, hi)
+	}
+	walk(0, len(t.nodes))
+	slices.Sort(r)
+	return r
+}
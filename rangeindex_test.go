@@ -0,0 +1,136 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestDateRangeIndex(t *testing.T) {
+	rs := []DateRange{dr(1, 10), dr(5, 5), dr(8, 20), dr(12, 11), dr(15, 31), dr(21, 21)}
+	x := NewDateRangeIndex(rs)
+	for _, test := range []struct {
+		d    int
+		want []int
+	}{
+		{1, []int{0}},
+		{5, []int{0, 1}},
+		{9, []int{0, 2}},
+		{11, []int{2}},
+		{12, []int{2}},
+		{21, []int{4, 5}},
+		{31, []int{4}},
+	} {
+		d := Date{2024, time.January, test.d}
+		if got := x.Query(d); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Query(%v) = %v, want %v", d, got, test.want)
+		}
+	}
+	if got := x.Query(Date{2024, 2, 1}); got != nil {
+		t.Errorf("Query(2024-02-01) = %v, want none", got)
+	}
+	if got, want := x.QueryRange(dr(11, 16)), []int{2, 4}; !reflect.DeepEqual(got, want) {
+		t.Errorf("QueryRange(%v) = %v, want %v", dr(11, 16), got, want)
+	}
+	if got := NewDateRangeIndex(nil).Query(Date{2024, 1, 1}); got != nil {
+		t.Errorf("empty index Query = %v, want none", got)
+	}
+}
+
+func TestDateTimeRangeIndex(t *testing.T) {
+	at := func(d, h int) DateTime { return DateTime{Date{2024, time.January, d}, Time{Hour: h}} }
+	rs := []DateTimeRange{
+		{at(1, 9), at(1, 17)},
+		{at(1, 12), at(1, 12)}, // empty
+		{at(1, 17), at(2, 9)},
+		{at(1, 8), at(1, 10)},
+	}
+	x := NewDateTimeRangeIndex(rs)
+	for _, test := range []struct {
+		dt   DateTime
+		want []int
+	}{
+		{at(1, 8), []int{3}},
+		{at(1, 9), []int{0, 3}},
+		{at(1, 12), []int{0}},
+		{at(1, 17), []int{2}},
+		{at(2, 9), nil},
+	} {
+		if got := x.Query(test.dt); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Query(%v) = %v, want %v", test.dt, got, test.want)
+		}
+	}
+	for _, test := range []struct {
+		r    DateTimeRange
+		want []int
+	}{
+		{DateTimeRange{at(1, 10), at(1, 17)}, []int{0}},
+		{DateTimeRange{at(1, 16), at(1, 18)}, []int{0, 2}},
+		{DateTimeRange{at(1, 0), at(3, 0)}, []int{0, 2, 3}},
+		// An empty range overlaps nothing, even inside another range.
+		{DateTimeRange{at(1, 12), at(1, 12)}, nil},
+	} {
+		if got := x.QueryRange(test.r); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("QueryRange(%v) = %v, want %v", test.r, got, test.want)
+		}
+	}
+}
+
+// TestRangeIndexRandom checks the indexes against a linear scan with
+// Overlaps.
+func TestRangeIndexRandom(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	base := DateTime{Date: Date{2024, 1, 1}}
+	for i := 0; i < 100; i++ {
+		n := rng.Intn(40)
+		drs := make([]DateRange, n)
+		dtrs := make([]DateTimeRange, n)
+		for j := range drs {
+			start := Date{2024, 1, 1}.AddDays(rng.Intn(60))
+			drs[j] = DateRange{start, start.AddDays(rng.Intn(15) - 2)}
+			from := base.Add(time.Duration(rng.Intn(1000)) * time.Hour)
+			dtrs[j] = DateTimeRange{from, from.Add(time.Duration(rng.Intn(100)-10) * time.Hour)}
+		}
+		dx, dtx := NewDateRangeIndex(drs), NewDateTimeRangeIndex(dtrs)
+		for k := 0; k < 20; k++ {
+			qs := Date{2024, 1, 1}.AddDays(rng.Intn(70) - 5)
+			q := DateRange{qs, qs.AddDays(rng.Intn(10))}
+			var want []int
+			for j, r := range drs {
+				if !r.Start.After(r.End) && r.Overlaps(q) {
+					want = append(want, j)
+				}
+			}
+			if got := dx.QueryRange(q); !reflect.DeepEqual(got, want) {
+				t.Fatalf("DateRangeIndex.QueryRange(%v) = %v, want %v for %v", q, got, want, drs)
+			}
+
+			from := base.Add(time.Duration(rng.Intn(1100)-50) * time.Hour)
+			dq := DateTimeRange{from, from.Add(time.Duration(rng.Intn(50)) * time.Hour)}
+			want = nil
+			for j, r := range dtrs {
+				if r.Overlaps(dq) {
+					want = append(want, j)
+				}
+			}
+			if got := dtx.QueryRange(dq); !reflect.DeepEqual(got, want) {
+				t.Fatalf("DateTimeRangeIndex.QueryRange(%v) = %v, want %v for %v", dq, got, want, dtrs)
+			}
+		}
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import "slices"
+
+// The functions in this file treat a []DateRange as the set of dates that
+// its ranges cover. Those returning a set return it normalized: sorted by
+// start date, with no two ranges overlapping or adjacent. Invalid ranges,
+// including those whose Start is after their End, are ignored.
+
+// NormalizeRanges returns the normalized form of rs, merging ranges that
+// overlap or abut. The argument is not modified.
+func NormalizeRanges(rs []DateRange) []DateRange {
+	var r []DateRange
+	for _, x := range rs {
+		if x.IsValid() {
+			r = append(r, x)
+		}
+	}
+	slices.SortFunc(r, func(a, b DateRange) int { return a.Start.Compare(b.Start) })
+	n := 0
+	for _, x := range r {
+		if n > 0 && !x.Start.After(r[n-1].End.AddDays(1)) {
+			if x.End.After(r[n-1].End) {
+				r[n-1].End = x.End
+			}
+			continue
+		}
+		r[n] = x
+		n++
+	}
+	return r[:n]
+}
+
+// UnionRanges returns the dates that are in a, b, or both.
+// The arguments are not modified.
+func UnionRanges(a, b []DateRange) []DateRange {
+	return NormalizeRanges(append(slices.Clone(a), b...))
+}
+
+// IntersectRanges returns the dates that are in both a and b.
+// The arguments are not modified.
+func IntersectRanges(a, b []DateRange) []DateRange {
+	a, b = NormalizeRanges(a), NormalizeRanges(b)
+	var r []DateRange
+	for len(a) > 0 && len(b) > 0 {
+		if x, ok := a[0].Intersect(b[0]); ok {
+			r = append(r, x)
+		}
+		if a[0].End.Before(b[0].End) {
+			a = a[1:]
+		} else {
+			b = b[1:]
+		}
+	}
+	return r
+}
+
+// SubtractRanges returns the dates that are in a but not in b.
+// The arguments are not modified.
+func SubtractRanges(a, b []DateRange) []DateRange {
+	a, b = NormalizeRanges(a), NormalizeRanges(b)
+	var r []DateRange
+	for _, x := range a {
+		for len(b) > 0 && b[0].End.Before(x.Start) {
+			b = b[1:]
+		}
+		for _, y := range b {
+			if y.Start.After(x.End) {
+				break
+			}
+			if y.Start.After(x.Start) {
+				r = append(r, DateRange{Start: x.Start, End: y.Start.AddDays(-1)})
+			}
+			x.Start = y.End.AddDays(1)
+		}
+		if !x.Start.After(x.End) {
+			r = append(r, x)
+		}
+	}
+	return r
+}
+
+// RangeGaps returns the dates of within that no range of rs covers, such as
+// the periods in which no insurance policy was in force.
+// The argument is not modified.
+func RangeGaps(rs []DateRange, within DateRange) []DateRange {
+	return SubtractRanges([]DateRange{within}, rs)
+}
@@ -0,0 +1,155 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// dr returns the range of the given days of January 2024.
+func dr(start, end int) DateRange {
+	return DateRange{Date{2024, time.January, start}, Date{2024, time.January, end}}
+}
+
+func TestRangeSetOperations(t *testing.T) {
+	for _, test := range []struct {
+		a, b                         []DateRange
+		union, intersect, aSubtractB []DateRange
+	}{
+		{
+			a:          nil,
+			b:          nil,
+			union:      nil,
+			intersect:  nil,
+			aSubtractB: nil,
+		},
+		{
+			a:          []DateRange{dr(1, 10)},
+			b:          []DateRange{dr(5, 15)},
+			union:      []DateRange{dr(1, 15)},
+			intersect:  []DateRange{dr(5, 10)},
+			aSubtractB: []DateRange{dr(1, 4)},
+		},
+		{
+			// Adjacent ranges merge.
+			a:          []DateRange{dr(1, 5)},
+			b:          []DateRange{dr(6, 10)},
+			union:      []DateRange{dr(1, 10)},
+			intersect:  nil,
+			aSubtractB: []DateRange{dr(1, 5)},
+		},
+		{
+			a:          []DateRange{dr(20, 25), dr(1, 10)},
+			b:          []DateRange{dr(3, 4), dr(8, 22)},
+			union:      []DateRange{dr(1, 25)},
+			intersect:  []DateRange{dr(3, 4), dr(8, 10), dr(20, 22)},
+			aSubtractB: []DateRange{dr(1, 2), dr(5, 7), dr(23, 25)},
+		},
+		{
+			// Invalid ranges are ignored.
+			a:          []DateRange{dr(10, 1), dr(2, 3)},
+			b:          []DateRange{dr(3, 3), {Date{2024, 2, 30}, Date{2024, 3, 1}}},
+			union:      []DateRange{dr(2, 3)},
+			intersect:  []DateRange{dr(3, 3)},
+			aSubtractB: []DateRange{dr(2, 2)},
+		},
+		{
+			a:          []DateRange{dr(5, 6)},
+			b:          []DateRange{dr(1, 31)},
+			union:      []DateRange{dr(1, 31)},
+			intersect:  []DateRange{dr(5, 6)},
+			aSubtractB: nil,
+		},
+	} {
+		if got := UnionRanges(test.a, test.b); !reflect.DeepEqual(got, test.union) {
+			t.Errorf("UnionRanges(%v, %v) = %v, want %v", test.a, test.b, got, test.union)
+		}
+		if got := IntersectRanges(test.a, test.b); !reflect.DeepEqual(got, test.intersect) {
+			t.Errorf("IntersectRanges(%v, %v) = %v, want %v", test.a, test.b, got, test.intersect)
+		}
+		if got := SubtractRanges(test.a, test.b); !reflect.DeepEqual(got, test.aSubtractB) {
+			t.Errorf("SubtractRanges(%v, %v) = %v, want %v", test.a, test.b, got, test.aSubtractB)
+		}
+	}
+}
+
+func TestNormalizeRangesDoesNotModify(t *testing.T) {
+	rs := []DateRange{dr(5, 9), dr(1, 3), dr(2, 6)}
+	want := append([]DateRange(nil), rs...)
+	if got := NormalizeRanges(rs); !reflect.DeepEqual(got, []DateRange{dr(1, 9)}) {
+		t.Errorf("NormalizeRanges(%v) = %v, want [%v]", want, got, dr(1, 9))
+	}
+	if !reflect.DeepEqual(rs, want) {
+		t.Errorf("NormalizeRanges modified its argument: %v, want %v", rs, want)
+	}
+}
+
+func TestRangeGaps(t *testing.T) {
+	policies := []DateRange{dr(3, 10), dr(15, 20), dr(18, 25)}
+	want := []DateRange{dr(1, 2), dr(11, 14), dr(26, 31)}
+	if got := RangeGaps(policies, dr(1, 31)); !reflect.DeepEqual(got, want) {
+		t.Errorf("RangeGaps(%v, %v) = %v, want %v", policies, dr(1, 31), got, want)
+	}
+	if got := RangeGaps(policies, dr(4, 9)); got != nil {
+		t.Errorf("RangeGaps(%v, %v) = %v, want none", policies, dr(4, 9), got)
+	}
+}
+
+// TestRangeSetOperationsRandom checks the set operations against a
+// day-by-day evaluation of random sets of ranges.
+func TestRangeSetOperationsRandom(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	randomRanges := func() []DateRange {
+		rs := make([]DateRange, rng.Intn(5))
+		for i := range rs {
+			start := 1 + rng.Intn(31)
+			rs[i] = dr(start, start+rng.Intn(8)-1)
+		}
+		return rs
+	}
+	covered := func(rs []DateRange, d Date) bool {
+		for _, r := range rs {
+			if r.IsValid() && r.Contains(d) {
+				return true
+			}
+		}
+		return false
+	}
+	for i := 0; i < 500; i++ {
+		a, b := randomRanges(), randomRanges()
+		for _, op := range []struct {
+			name string
+			got  []DateRange
+			in   func(inA, inB bool) bool
+		}{
+			{"UnionRanges", UnionRanges(a, b), func(inA, inB bool) bool { return inA || inB }},
+			{"IntersectRanges", IntersectRanges(a, b), func(inA, inB bool) bool { return inA && inB }},
+			{"SubtractRanges", SubtractRanges(a, b), func(inA, inB bool) bool { return inA && !inB }},
+		} {
+			if !reflect.DeepEqual(op.got, NormalizeRanges(op.got)) {
+				t.Errorf("%s(%v, %v) = %v, not normalized", op.name, a, b, op.got)
+			}
+			for d := (Date{2023, 12, 31}); d.Before(Date{2024, 2, 10}); d = d.AddDays(1) {
+				if want := op.in(covered(a, d), covered(b, d)); covered(op.got, d) != want {
+					t.Errorf("%s(%v, %v) = %v: contains %v = %t, want %t", op.name, a, b, op.got, d, !want, want)
+					break
+				}
+			}
+		}
+	}
+}
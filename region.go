@@ -0,0 +1,111 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"strings"
+	"time"
+)
+
+// WeekInfo describes the conventions of a region for the days of the week.
+type WeekInfo struct {
+	FirstDay time.Weekday // The first day of the week in calendars.
+	Weekend  WeekdaySet   // The days of the weekend.
+}
+
+// BusinessCalendar returns a BusinessCalendar whose weekend is that of w,
+// with no holidays. If w has no weekend, neither has the calendar.
+func (w WeekInfo) BusinessCalendar() *BusinessCalendar {
+	return &BusinessCalendar{Weekend: w.Weekend, NoWeekend: w.Weekend == 0}
+}
+
+// The following tables are derived from the weekData of the Unicode CLDR
+// supplemental data. Regions not listed use the world default: weeks start
+// on Monday, and the weekend is Saturday and Sunday.
+var (
+	regionFirstDay = map[string]time.Weekday{}
+
+	firstDayRegions = map[time.Weekday]string{
+		time.Friday:   "MV",
+		time.Saturday: "AE AF BH DJ DZ EG IQ IR JO KW LY OM QA SD SY",
+		time.Sunday: "AG AS BD BR BS BT BW BZ CA CN CO DM DO ET GT GU HK HN ID IL IN " +
+			"JM JP KE KH KR LA MH MM MO MT MX MZ NI NP PA PE PH PK PR PT PY SA SG SV " +
+			"TH TT TW UM US VE VI WS YE ZA ZW",
+	}
+
+	regionWeekend = map[string]WeekdaySet{}
+
+	weekendRegions = map[WeekdaySet]string{
+		WeekdaysOf(time.Friday, time.Saturday): "AE BH DZ EG IL IQ JO KW LY OM QA SA SD SY YE",
+		WeekdaysOf(time.Thursday, time.Friday): "AF",
+		WeekdaysOf(time.Friday):                "IR",
+		WeekdaysOf(time.Sunday):                "IN UG",
+	}
+)
+
+func init() {
+	for day, regions := range firstDayRegions {
+		for _, r := range strings.Fields(regions) {
+			regionFirstDay[r] = day
+		}
+	}
+	for weekend, regions := range weekendRegions {
+		for _, r := range strings.Fields(regions) {
+			regionWeekend[r] = weekend
+		}
+	}
+}
+
+// RegionWeekInfo returns the week conventions of a region, given either as a
+// region code in upper case, such as "US", or as a BCP 47 language tag with
+// a region subtag, such as "en-US" or "ar_SA". Unknown regions, and tags without a
+// region, get the world default: weeks start on Monday, and the weekend is
+// Saturday and Sunday.
+func RegionWeekInfo(tag string) WeekInfo {
+	region := bcp47Region(tag)
+	info := WeekInfo{FirstDay: time.Monday, Weekend: SaturdaySunday}
+	if day, ok := regionFirstDay[region]; ok {
+		info.FirstDay = day
+	}
+	if weekend, ok := regionWeekend[region]; ok {
+		info.Weekend = weekend
+	}
+	return info
+}
+
+// bcp47Region returns the upper-case region subtag of a BCP 47 language tag,
+// or the tag itself if it is an upper-case two-letter region code. A lone
+// lower-case subtag, such as "af", is a language. Subtags of extensions and
+// private use, such as the "ca" of "en-u-ca-buddhist", are not regions.
+func bcp47Region(tag string) string {
+	subtags := strings.FieldsFunc(tag, func(r rune) bool { return r == '-' || r == '_' })
+	if len(subtags) == 1 && len(tag) == 2 && strings.ToUpper(tag) == tag {
+		return tag
+	}
+	if len(subtags) == 0 || len(subtags[0]) == 1 {
+		return ""
+	}
+	// The region follows the language and any extended language and script
+	// subtags, and comes before any variants and singletons.
+	for _, s := range subtags[1:] {
+		if len(s) == 2 || (len(s) == 3 && isDigits(s)) {
+			return strings.ToUpper(s)
+		}
+		if len(s) != 3 && (len(s) != 4 || isDigit(s[0])) {
+			break
+		}
+	}
+	return ""
+}
@@ -0,0 +1,82 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRegionWeekInfo(t *testing.T) {
+	satSun := SaturdaySunday
+	friSat := WeekdaysOf(time.Friday, time.Saturday)
+	for _, test := range []struct {
+		tag     string
+		first   time.Weekday
+		weekend WeekdaySet
+	}{
+		{"US", time.Sunday, satSun},
+		{"en-US", time.Sunday, satSun},
+		{"en_us", time.Sunday, satSun},
+		{"DE", time.Monday, satSun},
+		{"de-DE", time.Monday, satSun},
+		{"ar-SA", time.Sunday, friSat},
+		{"ar_EG", time.Saturday, friSat},
+		{"he-IL", time.Sunday, friSat},
+		{"fa-IR", time.Saturday, WeekdaysOf(time.Friday)},
+		{"ps-AF", time.Saturday, WeekdaysOf(time.Thursday, time.Friday)},
+		{"hi-IN", time.Sunday, WeekdaysOf(time.Sunday)},
+		{"dv-MV", time.Friday, satSun},
+		{"zh-Hant-TW", time.Sunday, satSun},
+		// A lone lower-case subtag is a language, not a region: "af" is
+		// Afrikaans, not Afghanistan.
+		{"af", time.Monday, satSun},
+		{"es-419", time.Monday, satSun},
+		{"", time.Monday, satSun},
+		{"ZZ", time.Monday, satSun},
+	} {
+		got := RegionWeekInfo(test.tag)
+		if got.FirstDay != test.first || got.Weekend != test.weekend {
+			t.Errorf("RegionWeekInfo(%q) = %v, %v, want %v, %v", test.tag, got.FirstDay, got.Weekend, test.first, test.weekend)
+		}
+	}
+}
+
+func TestBcp47Region(t *testing.T) {
+	for tag, want := range map[string]string{
+		"US":         "US",
+		"en":         "",
+		"en-US":      "US",
+		"en_gb":      "GB",
+		"zh-Hant-TW": "TW",
+		"sr-Latn":    "",
+		"es-419":     "419",
+		"de-CH-1996": "CH",
+		"":           "",
+		"zh-yue-HK":  "HK",
+		// Subtags after a variant or a singleton are not regions.
+		"en-u-ca-buddhist":  "",
+		"en-x-us":           "",
+		"en-US-u-ca-iso":    "US",
+		"th-u-nu-thai-x-ca": "",
+		"x-us":              "",
+		"sl-rozaj-biske":    "",
+		"de-1996-CH":        "",
+	} {
+		if got := bcp47Region(tag); got != want {
+			t.Errorf("bcp47Region(%q) = %q, want %q", tag, got, want)
+		}
+	}
+}
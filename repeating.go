@@ -0,0 +1,214 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"fmt"
+	"iter"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// A RepeatingDateRange is an ISO 8601 repeating interval of dates, such as
+// "R5/2024-01-01/P1W": a sequence of consecutive ranges of the same period,
+// the first of which starts on Start.
+type RepeatingDateRange struct {
+	Count  int    // The number of ranges; negative if unbounded.
+	Start  Date   // The first date of the first range.
+	Period Period // The length of each range.
+}
+
+// ParseRepeatingDateRange parses an ISO 8601 repeating interval in one of the
+// forms Rn/START/PERIOD and Rn/START/END, where START/END is the first range
+// in the format accepted by ParseDateRange and n is the number of ranges.
+// If n is omitted, as in "R/2024-01-01/P1M", the repetition is unbounded.
+// The period must be positive, so that each range starts after the last.
+func ParseRepeatingDateRange(s string) (RepeatingDateRange, error) {
+	count, first, err := splitRepeating(s)
+	if err != nil {
+		return RepeatingDateRange{}, err
+	}
+	r := RepeatingDateRange{Count: count}
+	start, end, _ := strings.Cut(first, "/")
+	if r.Start, err = ParseDate(start); err != nil {
+		return RepeatingDateRange{}, err
+	}
+	if strings.HasPrefix(end, "P") {
+		if r.Period, err = ParsePeriod(end); err != nil {
+			return RepeatingDateRange{}, err
+		}
+	} else {
+		last, err := ParseDate(end)
+		if err != nil {
+			return RepeatingDateRange{}, err
+		}
+		r.Period = periodBetween(r.Start, last.AddDays(1))
+	}
+	if !r.Start.AddPeriod(r.Period).After(r.Start) {
+		return RepeatingDateRange{}, fmt.Errorf("civil: repeating interval %q does not advance", s)
+	}
+	return r, nil
+}
+
+// splitRepeating splits the repetition count from an ISO 8601 repeating
+// interval, returning -1 for an unbounded repetition.
+func splitRepeating(s string) (count int, interval string, err error) {
+	rep, interval, ok := strings.Cut(s, "/")
+	if !ok || !strings.HasPrefix(rep, "R") || !strings.Contains(interval, "/") {
+		return 0, "", fmt.Errorf("civil: cannot parse %q as a repeating interval", s)
+	}
+	if rep == "R" || rep == "R-1" {
+		return -1, interval, nil
+	}
+	if !isDigits(rep[1:]) {
+		return 0, "", fmt.Errorf("civil: invalid repetition count in %q", s)
+	}
+	count, err = strconv.Atoi(rep[1:])
+	if err != nil {
+		return 0, "", fmt.Errorf("civil: invalid repetition count in %q", s)
+	}
+	return count, interval, nil
+}
+
+// String returns the repeating interval in the form Rn/START/PERIOD.
+func (r RepeatingDateRange) String() string {
+	return repetitions(r.Count) + "/" + r.Start.String() + "/" + r.Period.String()
+}
+
+// repetitions returns the repetition designator for count.
+func repetitions(count int) string {
+	if count < 0 {
+		return "R"
+	}
+	return "R" + strconv.Itoa(count)
+}
+
+// All returns an iterator over the ranges, in order. The k'th range starts
+// k periods after Start, as computed by Date.AddPeriod, rather than one
+// period after the start of the previous range. An unbounded repetition
+// stops at MaxDate, or after the first range if Period does not advance.
+func (r RepeatingDateRange) All() iter.Seq[DateRange] {
+	return func(yield func(DateRange) bool) {
+		for k := 0; r.Count < 0 || k < r.Count; k++ {
+			rg := DateRange{
+				Start: r.Start.AddPeriod(r.Period.mul(k)),
+				End:   r.Start.AddPeriod(r.Period.mul(k + 1)).AddDays(-1),
+			}
+			if rg.Start.After(MaxDate) || !yield(rg) {
+				return
+			}
+			if r.Count < 0 && !rg.End.AddDays(1).After(rg.Start) {
+				return
+			}
+		}
+	}
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// The output is the result of r.String().
+func (r RepeatingDateRange) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// The value is expected to be a string in a format accepted by
+// ParseRepeatingDateRange.
+func (r *RepeatingDateRange) UnmarshalText(data []byte) error {
+	var err error
+	*r, err = ParseRepeatingDateRange(string(data))
+	return err
+}
+
+// A RepeatingDateTimeRange is an ISO 8601 repeating interval of datetimes,
+// such as "R5/2024-01-01T02:00:00/PT4H": a sequence of consecutive ranges of
+// the same span, the first of which starts at Start.
+type RepeatingDateTimeRange struct {
+	Count int      // The number of ranges; negative if unbounded.
+	Start DateTime // The start of the first range.
+	Span  Span     // The length of each range.
+}
+
+// ParseRepeatingDateTimeRange parses an ISO 8601 repeating interval in one
+// of the forms Rn/START/DURATION and Rn/START/END, where START/END is the
+// first range in the format accepted by ParseDateTimeRange and n is the
+// number of ranges. If n is omitted, the repetition is unbounded. The
+// duration must be positive, so that each range starts after the last.
+func ParseRepeatingDateTimeRange(s string) (RepeatingDateTimeRange, error) {
+	count, first, err := splitRepeating(s)
+	if err != nil {
+		return RepeatingDateTimeRange{}, err
+	}
+	r := RepeatingDateTimeRange{Count: count}
+	start, end, _ := strings.Cut(first, "/")
+	if r.Start, err = ParseDateTime(start); err != nil {
+		return RepeatingDateTimeRange{}, err
+	}
+	if strings.HasPrefix(end, "P") {
+		if r.Span, err = ParseSpan(end); err != nil {
+			return RepeatingDateTimeRange{}, err
+		}
+	} else {
+		last, err := ParseDateTime(end)
+		if err != nil {
+			return RepeatingDateTimeRange{}, err
+		}
+		r.Span = SpanBetween(r.Start, last)
+	}
+	if !r.Span.AddTo(r.Start).After(r.Start) {
+		return RepeatingDateTimeRange{}, fmt.Errorf("civil: repeating interval %q does not advance", s)
+	}
+	return r, nil
+}
+
+// String returns the repeating interval in the form Rn/START/DURATION.
+func (r RepeatingDateTimeRange) String() string {
+	return repetitions(r.Count) + "/" + r.Start.String() + "/" + r.Span.String()
+}
+
+// All returns an iterator over the ranges, in order. The k'th range starts
+// k spans after Start. An unbounded repetition stops at MaxDateTime, or
+// after the first range if Span does not advance.
+func (r RepeatingDateTimeRange) All() iter.Seq[DateTimeRange] {
+	return func(yield func(DateTimeRange) bool) {
+		at := func(k int) DateTime {
+			return Span{Period: r.Span.Period.mul(k), Duration: time.Duration(k) * r.Span.Duration}.AddTo(r.Start)
+		}
+		for k := 0; r.Count < 0 || k < r.Count; k++ {
+			rg := DateTimeRange{Start: at(k), End: at(k + 1)}
+			if rg.Start.After(MaxDateTime) || !yield(rg) {
+				return
+			}
+			if r.Count < 0 && rg.IsEmpty() {
+				return
+			}
+		}
+	}
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// The output is the result of r.String().
+func (r RepeatingDateTimeRange) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// The value is expected to be a string in a format accepted by
+// ParseRepeatingDateTimeRange.
+func (r *RepeatingDateTimeRange) UnmarshalText(data []byte) error {
+	var err error
+	*r, err = ParseRepeatingDateTimeRange(string(data))
+	return err
+}
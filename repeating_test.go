@@ -0,0 +1,147 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestParseRepeatingDateRange(t *testing.T) {
+	for _, test := range []struct {
+		s    string
+		want RepeatingDateRange
+		str  string
+	}{
+		{"R5/2024-01-01/P1W", RepeatingDateRange{5, Date{2024, 1, 1}, Period{Weeks: 1}}, "R5/2024-01-01/P1W"},
+		{"R/2024-01-31/P1M", RepeatingDateRange{-1, Date{2024, 1, 31}, Period{Months: 1}}, "R/2024-01-31/P1M"},
+		{"R-1/2024-01-01/P1D", RepeatingDateRange{-1, Date{2024, 1, 1}, Period{Days: 1}}, "R/2024-01-01/P1D"},
+		{"R0/2024-01-01/P1D", RepeatingDateRange{0, Date{2024, 1, 1}, Period{Days: 1}}, "R0/2024-01-01/P1D"},
+		{"R3/2024-01-01/2024-01-31", RepeatingDateRange{3, Date{2024, 1, 1}, Period{Months: 1}}, "R3/2024-01-01/P1M"},
+		{"R2/2024-01-01/2024-01-10", RepeatingDateRange{2, Date{2024, 1, 1}, Period{Days: 10}}, "R2/2024-01-01/P10D"},
+	} {
+		got, err := ParseRepeatingDateRange(test.s)
+		if err != nil || got != test.want {
+			t.Errorf("ParseRepeatingDateRange(%q) = %+v, %v, want %+v", test.s, got, err, test.want)
+			continue
+		}
+		if s := got.String(); s != test.str {
+			t.Errorf("%+v.String() = %q, want %q", got, s, test.str)
+		}
+		if back, err := ParseRepeatingDateRange(got.String()); err != nil || back != got {
+			t.Errorf("ParseRepeatingDateRange(%q) = %+v, %v, want %+v", got.String(), back, err, got)
+		}
+	}
+	for _, s := range []string{
+		"",
+		"R5",
+		"R5/2024-01-01",
+		"5/2024-01-01/P1D",
+		"Rx/2024-01-01/P1D",
+		"R-2/2024-01-01/P1D",
+		"R5/2024-02-30/P1D",
+		"R5/2024-01-01/P1X",
+		"R/2024-01-01/P0D",
+		"R/2024-01-01/P-1D",
+		"R5/2024-01-10/2024-01-01",
+	} {
+		if got, err := ParseRepeatingDateRange(s); err == nil {
+			t.Errorf("ParseRepeatingDateRange(%q) = %+v, want error", s, got)
+		}
+	}
+}
+
+func TestRepeatingDateRangeAll(t *testing.T) {
+	r := RepeatingDateRange{Count: 3, Start: Date{2024, 1, 31}, Period: Period{Months: 1}}
+	// Each range starts k months after Start, so the day of the month does
+	// not drift after February.
+	want := []DateRange{
+		{Date{2024, 1, 31}, Date{2024, 3, 1}},
+		{Date{2024, 3, 2}, Date{2024, 3, 30}},
+		{Date{2024, 3, 31}, Date{2024, 4, 30}},
+	}
+	if got := slices.Collect(r.All()); !slices.Equal(got, want) {
+		t.Errorf("%v.All() = %v, want %v", r, got, want)
+	}
+
+	r = RepeatingDateRange{Count: -1, Start: Date{9999, 12, 1}, Period: Period{Weeks: 2}}
+	if got := slices.Collect(r.All()); len(got) != 3 || got[2].Start != (Date{9999, 12, 29}) {
+		t.Errorf("%v.All() = %v, want 3 ranges ending at MaxDate", r, got)
+	}
+	// An unbounded repetition that does not advance stops after one range.
+	r = RepeatingDateRange{Count: -1, Start: Date{2024, 1, 1}}
+	if got := slices.Collect(r.All()); len(got) != 1 {
+		t.Errorf("%+v.All() = %v, want one range", r, got)
+	}
+}
+
+func TestParseRepeatingDateTimeRange(t *testing.T) {
+	start := DateTime{Date{2024, 1, 1}, Time{2, 0, 0, 0}}
+	for _, test := range []struct {
+		s    string
+		want RepeatingDateTimeRange
+		str  string
+	}{
+		{"R5/2024-01-01T02:00:00/PT4H", RepeatingDateTimeRange{5, start, Span{Duration: 4 * time.Hour}}, "R5/2024-01-01T02:00:00/PT4H"},
+		{"R/2024-01-01T02:00:00/P1DT30M", RepeatingDateTimeRange{-1, start, Span{Period{Days: 1}, 30 * time.Minute}}, "R/2024-01-01T02:00:00/P1DT30M"},
+		{"R2/2024-01-01T02:00:00/2024-01-02T03:00:00", RepeatingDateTimeRange{2, start, Span{Period{Days: 1}, time.Hour}}, "R2/2024-01-01T02:00:00/P1DT1H"},
+	} {
+		got, err := ParseRepeatingDateTimeRange(test.s)
+		if err != nil || got != test.want {
+			t.Errorf("ParseRepeatingDateTimeRange(%q) = %+v, %v, want %+v", test.s, got, err, test.want)
+			continue
+		}
+		if s := got.String(); s != test.str {
+			t.Errorf("%+v.String() = %q, want %q", got, s, test.str)
+		}
+		if back, err := ParseRepeatingDateTimeRange(got.String()); err != nil || back != got {
+			t.Errorf("ParseRepeatingDateTimeRange(%q) = %+v, %v, want %+v", got.String(), back, err, got)
+		}
+	}
+	for _, s := range []string{
+		"R5/2024-01-01/PT4H",
+		"R5/2024-01-01T02:00:00",
+		"R5/2024-01-01T02:00:00/PT",
+		"R/2024-01-01T02:00:00/PT0S",
+		"R/2024-01-01T02:00:00/PT-1H",
+		"R2/2024-01-01T02:00:00/2024-01-01T01:00:00",
+	} {
+		if got, err := ParseRepeatingDateTimeRange(s); err == nil {
+			t.Errorf("ParseRepeatingDateTimeRange(%q) = %+v, want error", s, got)
+		}
+	}
+}
+
+func TestRepeatingDateTimeRangeAll(t *testing.T) {
+	at := func(d, h int) DateTime { return DateTime{Date{2024, 1, d}, Time{Hour: h}} }
+	r := RepeatingDateTimeRange{Count: 3, Start: at(1, 22), Span: Span{Duration: 3 * time.Hour}}
+	want := []DateTimeRange{{at(1, 22), at(2, 1)}, {at(2, 1), at(2, 4)}, {at(2, 4), at(2, 7)}}
+	if got := slices.Collect(r.All()); !slices.Equal(got, want) {
+		t.Errorf("%+v.All() = %v, want %v", r, got, want)
+	}
+	n := 0
+	for range (RepeatingDateTimeRange{Count: -1, Start: at(1, 0), Span: Span{Duration: time.Hour}}).All() {
+		if n++; n == 100 {
+			break
+		}
+	}
+	if n != 100 {
+		t.Errorf("unbounded All() yielded %d ranges before break, want 100", n)
+	}
+	if got := slices.Collect((RepeatingDateTimeRange{Count: -1, Start: at(1, 0)}).All()); len(got) != 1 {
+		t.Errorf("unbounded All() with a zero span = %v, want one range", got)
+	}
+}
@@ -0,0 +1,171 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"fmt"
+	"time"
+)
+
+// A RetailPattern is the number of weeks in each of the three periods of a
+// quarter of a retail calendar.
+type RetailPattern int
+
+const (
+	Pattern445 RetailPattern = iota // 4, 4 and 5 weeks
+	Pattern454                      // 4, 5 and 4 weeks, as in the NRF calendar
+	Pattern544                      // 5, 4 and 4 weeks
+)
+
+// String returns the pattern in the form "4-4-5".
+func (p RetailPattern) String() string {
+	w := p.weeks()
+	return fmt.Sprintf("%d-%d-%d", w[0], w[1], w[2])
+}
+
+// weeks returns the number of weeks in each period of a quarter.
+func (p RetailPattern) weeks() [3]int {
+	switch p {
+	case Pattern454:
+		return [3]int{4, 5, 4}
+	case Pattern544:
+		return [3]int{5, 4, 4}
+	}
+	return [3]int{4, 4, 5}
+}
+
+// A RetailCalendar is a 52–53 week merchandising calendar. Each year
+// consists of whole weeks and ends on the same day of the week, so that
+// periods and quarters are comparable from year to year. Each quarter has
+// three periods of four or five weeks. Years in which the end day drifts
+// far enough have 53 weeks; the extra week is added to the last period.
+//
+// A retail year is labeled by the calendar year in which most of it falls:
+// the calendar year in which it ends if it ends in July or later, and
+// otherwise the calendar year in which it starts. For example, the NRF
+// calendar year 2023 runs from January 29, 2023 to February 3, 2024.
+type RetailCalendar struct {
+	Pattern RetailPattern
+
+	// EndMonth and EndWeekday specify the month near whose end the year
+	// ends, and the day of the week on which it ends.
+	EndMonth   time.Month
+	EndWeekday time.Weekday
+
+	// If Nearest is true, the year ends on the EndWeekday nearest the last
+	// day of EndMonth, which may fall early in the following month.
+	// Otherwise it ends on the last EndWeekday of EndMonth.
+	Nearest bool
+}
+
+// NRFCalendar is the 4-5-4 calendar of the National Retail Federation,
+// whose years end on the Saturday nearest the end of January.
+var NRFCalendar = RetailCalendar{
+	Pattern:    Pattern454,
+	EndMonth:   time.January,
+	EndWeekday: time.Saturday,
+	Nearest:    true,
+}
+
+// A RetailDate identifies the position of a date in a retail calendar.
+type RetailDate struct {
+	Year    int // The retail year, labeled as described for RetailCalendar.
+	Quarter int // The quarter of the year; range [1-4]
+	Period  int // The period of the year; range [1-12]
+	Week    int // The week of the year; range [1-53]
+	Day     int // The day of the week, starting at 1.
+}
+
+// yearEnd returns the last day of the retail year y.
+func (c RetailCalendar) yearEnd(y int) Date {
+	if c.EndMonth < time.July {
+		y++
+	}
+	last := YearMonth{Year: y, Month: c.EndMonth}.LastDate()
+	back := (int(last.Weekday()) - int(c.EndWeekday) + 7) % 7
+	if c.Nearest && back > 3 {
+		return last.AddDays(7 - back)
+	}
+	return last.AddDays(-back)
+}
+
+// Year returns the dates of the retail year y.
+func (c RetailCalendar) Year(y int) DateRange {
+	return DateRange{Start: c.yearEnd(y - 1).AddDays(1), End: c.yearEnd(y)}
+}
+
+// Weeks returns the number of weeks in the retail year y: 52 or 53.
+func (c RetailCalendar) Weeks(y int) int {
+	return c.Year(y).Days() / 7
+}
+
+// Period returns the dates of period p, in the range [1-12], of the retail
+// year y.
+func (c RetailCalendar) Period(y, p int) DateRange {
+	start := c.Year(y).Start
+	weeks := c.Pattern.weeks()
+	for i := 1; i < p; i++ {
+		start = start.AddDays(7 * weeks[(i-1)%3])
+	}
+	n := weeks[(p-1)%3]
+	if p == 12 && c.Weeks(y) == 53 {
+		n++
+	}
+	return DateRange{Start: start, End: start.AddDays(7*n - 1)}
+}
+
+// Quarter returns the dates of quarter q, in the range [1-4], of the retail
+// year y.
+func (c RetailCalendar) Quarter(y, q int) DateRange {
+	return DateRange{Start: c.Period(y, 3*q-2).Start, End: c.Period(y, 3*q).End}
+}
+
+// Week returns the dates of week w, in the range [1-53], of the retail year
+// y. Week 53 exists only in a 53-week year.
+func (c RetailCalendar) Week(y, w int) DateRange {
+	start := c.Year(y).Start.AddDays(7 * (w - 1))
+	return DateRange{Start: start, End: start.AddDays(6)}
+}
+
+// Periods returns the dates of the twelve periods of the retail year y.
+func (c RetailCalendar) Periods(y int) [12]DateRange {
+	var ps [12]DateRange
+	for p := range ps {
+		ps[p] = c.Period(y, p+1)
+	}
+	return ps
+}
+
+// Of returns the position of d in the calendar.
+func (c RetailCalendar) Of(d Date) RetailDate {
+	// A year ending near a month end may end in the following month, so
+	// compare d with the year ends on both sides of its calendar year.
+	y := d.Year
+	for !d.After(c.yearEnd(y - 1)) {
+		y--
+	}
+	for d.After(c.yearEnd(y)) {
+		y++
+	}
+	start := c.Year(y).Start
+	days := d.DaysSince(start)
+	rd := RetailDate{Year: y, Week: days/7 + 1, Day: days%7 + 1}
+	rd.Period = 1
+	for rd.Period < 12 && c.Period(y, rd.Period).End.Before(d) {
+		rd.Period++
+	}
+	rd.Quarter = (rd.Period-1)/3 + 1
+	return rd
+}
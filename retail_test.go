@@ -0,0 +1,166 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNRFCalendar2023(t *testing.T) {
+	c := NRFCalendar
+	if got, want := c.Year(2023), (DateRange{Date{2023, 1, 29}, Date{2024, 2, 3}}); got != want {
+		t.Errorf("Year(2023) = %v, want %v", got, want)
+	}
+	if got, want := c.Year(2022), (DateRange{Date{2022, 1, 30}, Date{2023, 1, 28}}); got != want {
+		t.Errorf("Year(2022) = %v, want %v", got, want)
+	}
+	if got := c.Weeks(2023); got != 53 {
+		t.Errorf("Weeks(2023) = %d, want 53", got)
+	}
+	if got := c.Weeks(2022); got != 52 {
+		t.Errorf("Weeks(2022) = %d, want 52", got)
+	}
+	want := [12]DateRange{
+		{Date{2023, 1, 29}, Date{2023, 2, 25}},
+		{Date{2023, 2, 26}, Date{2023, 4, 1}},
+		{Date{2023, 4, 2}, Date{2023, 4, 29}},
+		{Date{2023, 4, 30}, Date{2023, 5, 27}},
+		{Date{2023, 5, 28}, Date{2023, 7, 1}},
+		{Date{2023, 7, 2}, Date{2023, 7, 29}},
+		{Date{2023, 7, 30}, Date{2023, 8, 26}},
+		{Date{2023, 8, 27}, Date{2023, 9, 30}},
+		{Date{2023, 10, 1}, Date{2023, 10, 28}},
+		{Date{2023, 10, 29}, Date{2023, 11, 25}},
+		{Date{2023, 11, 26}, Date{2023, 12, 30}},
+		// The 53rd week is added to the last period.
+		{Date{2023, 12, 31}, Date{2024, 2, 3}},
+	}
+	if got := c.Periods(2023); got != want {
+		t.Errorf("Periods(2023) = %v, want %v", got, want)
+	}
+	if got, want := c.Quarter(2023, 4), (DateRange{Date{2023, 10, 29}, Date{2024, 2, 3}}); got != want {
+		t.Errorf("Quarter(2023, 4) = %v, want %v", got, want)
+	}
+	if got, want := c.Week(2023, 53), (DateRange{Date{2024, 1, 28}, Date{2024, 2, 3}}); got != want {
+		t.Errorf("Week(2023, 53) = %v, want %v", got, want)
+	}
+}
+
+func TestRetailCalendarOf(t *testing.T) {
+	dec445 := RetailCalendar{Pattern: Pattern445, EndMonth: time.December, EndWeekday: time.Saturday, Nearest: true}
+	decLast := RetailCalendar{Pattern: Pattern445, EndMonth: time.December, EndWeekday: time.Saturday}
+	for _, test := range []struct {
+		c    RetailCalendar
+		d    Date
+		want RetailDate
+	}{
+		{NRFCalendar, Date{2023, 1, 29}, RetailDate{2023, 1, 1, 1, 1}},
+		{NRFCalendar, Date{2023, 1, 28}, RetailDate{2022, 4, 12, 52, 7}},
+		{NRFCalendar, Date{2024, 2, 3}, RetailDate{2023, 4, 12, 53, 7}},
+		{NRFCalendar, Date{2024, 2, 4}, RetailDate{2024, 1, 1, 1, 1}},
+		{NRFCalendar, Date{2023, 7, 4}, RetailDate{2023, 2, 6, 23, 3}},
+		// Years ending on the Saturday nearest December 31 may end in
+		// January.
+		{dec445, Date{2022, 1, 1}, RetailDate{2021, 4, 12, 52, 7}},
+		{dec445, Date{2022, 1, 2}, RetailDate{2022, 1, 1, 1, 1}},
+		{dec445, Date{2021, 1, 2}, RetailDate{2020, 4, 12, 53, 7}},
+		{dec445, Date{2021, 1, 3}, RetailDate{2021, 1, 1, 1, 1}},
+		{dec445, Date{2020, 12, 31}, RetailDate{2020, 4, 12, 53, 5}},
+		{decLast, Date{2021, 12, 25}, RetailDate{2021, 4, 12, 52, 7}},
+		{decLast, Date{2021, 12, 26}, RetailDate{2022, 1, 1, 1, 1}},
+		{decLast, Date{2021, 12, 31}, RetailDate{2022, 1, 1, 1, 6}},
+	} {
+		if got := test.c.Of(test.d); got != test.want {
+			t.Errorf("%+v.Of(%v) = %+v, want %+v", test.c, test.d, got, test.want)
+		}
+	}
+}
+
+func TestRetailCalendarOfConsistent(t *testing.T) {
+	var calendars []RetailCalendar
+	for _, m := range []time.Month{time.January, time.June, time.July, time.December} {
+		for _, nearest := range []bool{false, true} {
+			calendars = append(calendars, RetailCalendar{Pattern: Pattern454, EndMonth: m, EndWeekday: time.Saturday, Nearest: nearest})
+		}
+	}
+	for _, c := range calendars {
+		for d := (Date{2015, 1, 1}); d.Before(Date{2031, 1, 1}); d = d.AddDays(1) {
+			rd := c.Of(d)
+			if !c.Year(rd.Year).Contains(d) {
+				t.Fatalf("%+v.Of(%v) = %+v, outside %v", c, d, rd, c.Year(rd.Year))
+			}
+			if got := c.Week(rd.Year, rd.Week).Start.AddDays(rd.Day - 1); got != d {
+				t.Fatalf("%+v.Of(%v) = %+v, which is %v", c, d, rd, got)
+			}
+			if !c.Period(rd.Year, rd.Period).Contains(d) || !c.Quarter(rd.Year, rd.Quarter).Contains(d) {
+				t.Fatalf("%+v.Of(%v) = %+v, in the wrong period or quarter", c, d, rd)
+			}
+		}
+	}
+}
+
+func TestRetailPattern(t *testing.T) {
+	for _, test := range []struct {
+		p     RetailPattern
+		str   string
+		weeks [12]int
+	}{
+		{Pattern445, "4-4-5", [12]int{4, 4, 5, 4, 4, 5, 4, 4, 5, 4, 4, 5}},
+		{Pattern454, "4-5-4", [12]int{4, 5, 4, 4, 5, 4, 4, 5, 4, 4, 5, 4}},
+		{Pattern544, "5-4-4", [12]int{5, 4, 4, 5, 4, 4, 5, 4, 4, 5, 4, 4}},
+	} {
+		if got := test.p.String(); got != test.str {
+			t.Errorf("RetailPattern(%d).String() = %q, want %q", int(test.p), got, test.str)
+		}
+		c := NRFCalendar
+		c.Pattern = test.p
+		// NRF 2022 has 52 weeks, so every period has its pattern length.
+		periods := c.Periods(2022)
+		for i, r := range periods {
+			if got := r.Days(); got != 7*test.weeks[i] {
+				t.Errorf("%v: Period(2022, %d) = %v, %d days, want %d weeks", test.p, i+1, r, got, test.weeks[i])
+			}
+			if i > 0 && r.Start != periods[i-1].End.AddDays(1) {
+				t.Errorf("%v: Period(2022, %d) = %v does not follow %v", test.p, i+1, r, periods[i-1])
+			}
+		}
+	}
+}
+
+func TestRetailCalendarLastWeekday(t *testing.T) {
+	// A year ending on the last Saturday of August, labeled by the calendar
+	// year in which it ends.
+	c := RetailCalendar{Pattern: Pattern445, EndMonth: time.August, EndWeekday: time.Saturday}
+	for _, test := range []struct {
+		y     int
+		want  DateRange
+		weeks int
+	}{
+		{2023, DateRange{Date{2022, 8, 28}, Date{2023, 8, 26}}, 52},
+		{2024, DateRange{Date{2023, 8, 27}, Date{2024, 8, 31}}, 53},
+		{2025, DateRange{Date{2024, 9, 1}, Date{2025, 8, 30}}, 52},
+	} {
+		if got := c.Year(test.y); got != test.want {
+			t.Errorf("Year(%d) = %v, want %v", test.y, got, test.want)
+		}
+		if got := c.Weeks(test.y); got != test.weeks {
+			t.Errorf("Weeks(%d) = %d, want %d", test.y, got, test.weeks)
+		}
+	}
+	if got, want := c.Of(Date{2024, 8, 31}), (RetailDate{Year: 2024, Quarter: 4, Period: 12, Week: 53, Day: 7}); got != want {
+		t.Errorf("Of(2024-08-31) = %+v, want %+v", got, want)
+	}
+}
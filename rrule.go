@@ -0,0 +1,516 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// A Frequency is the period at which a recurrence rule repeats.
+type Frequency int
+
+const (
+	Daily Frequency = iota + 1
+	Weekly
+	Monthly
+	Yearly
+)
+
+// String returns the name of the frequency, such as "Weekly".
+func (f Frequency) String() string {
+	switch f {
+	case Daily:
+		return "Daily"
+	case Weekly:
+		return "Weekly"
+	case Monthly:
+		return "Monthly"
+	case Yearly:
+		return "Yearly"
+	}
+	return fmt.Sprintf("Frequency(%d)", int(f))
+}
+
+// A RecurringDay is an element of the BYDAY part of a recurrence rule: every
+// occurrence of a weekday, or the Nth, such as the second Tuesday (2TU) or
+// the last Friday (-1FR).
+type RecurringDay struct {
+	N       int // The occurrence within the month or year, negative from the end; 0 for every occurrence.
+	Weekday time.Weekday
+}
+
+// String returns the day in the form used by RFC 5545, such as "-1FR".
+func (d RecurringDay) String() string {
+	if d.N == 0 {
+		return weekdayCodes[d.Weekday]
+	}
+	return strconv.Itoa(d.N) + weekdayCodes[d.Weekday]
+}
+
+// An RRule is a recurrence rule, implementing the subset of the RRULE of
+// RFC 5545 made of the FREQ, INTERVAL, COUNT, UNTIL, BYMONTH, BYMONTHDAY,
+// BYDAY and BYSETPOS parts. Weeks start on Monday.
+//
+// BYSETPOS selects among the occurrences within each period, so that
+// "FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1" is the last weekday of
+// every month, and "FREQ=MONTHLY;BYMONTHDAY=-1" its last day.
+//
+// As RFC 5545 requires, dates that do not exist, such as February 30 or
+// February 29 of a common year, are skipped rather than moved, unless
+// LeapDay says otherwise. ParseRRule and String read and write LeapDay as
+// the RSCALE=GREGORIAN and SKIP parts of RFC 7529.
+type RRule struct {
+	Freq       Frequency
+	Interval   int            // The number of periods between recurrences; 0 means 1.
+	Count      int            // The number of occurrences; 0 means no limit.
+	Until      DateTime       // The latest occurrence, inclusive; the zero value means no limit.
+	ByMonth    []time.Month   // The months in which the rule recurs.
+	ByMonthDay []int          // The days of the month, negative from the end, on which the rule recurs.
+	ByDay      []RecurringDay // The days of the week on which the rule recurs.
+	BySetPos   []int          // The positions, negative from the end, of the occurrences to keep within each period.
+
+	// LeapDay, if not nil, is the policy for the monthly and yearly
+	// occurrences of a rule without BYDAY that fall on a day the month
+	// does not have, such as February 29 of a common year or April 31:
+	// ObserveFeb28 moves them back to the last day of the month, ObserveMar1
+	// forward to the first day of the next month, and SkipLeapDay skips
+	// them, as a nil LeapDay does.
+	LeapDay *LeapDayPolicy
+}
+
+// ParseRRule parses a recurrence rule in the form used by RFC 5545, such
+// as "FREQ=MONTHLY;BYDAY=-1FR;COUNT=12", with an optional "RRULE:" prefix.
+// A trailing 'Z' on UNTIL is discarded, and UNTIL is taken as civil time.
+func ParseRRule(s string) (RRule, error) {
+	var r RRule
+	seen := map[string]bool{}
+	for _, part := range strings.Split(strings.TrimPrefix(s, "RRULE:"), ";") {
+		name, value, ok := strings.Cut(part, "=")
+		name = strings.ToUpper(name)
+		if !ok || value == "" || seen[name] {
+			return RRule{}, fmt.Errorf("civil: malformed RRULE part %q", part)
+		}
+		seen[name] = true
+		var err error
+		switch name {
+		case "FREQ":
+			switch strings.ToUpper(value) {
+			case "DAILY":
+				r.Freq = Daily
+			case "WEEKLY":
+				r.Freq = Weekly
+			case "MONTHLY":
+				r.Freq = Monthly
+			case "YEARLY":
+				r.Freq = Yearly
+			default:
+				err = fmt.Errorf("civil: unsupported RRULE frequency %q", value)
+			}
+		case "INTERVAL":
+			r.Interval, err = parseRRuleInt(name, value, 1, 1<<31-1)
+		case "COUNT":
+			r.Count, err = parseRRuleInt(name, value, 1, 1<<31-1)
+		case "UNTIL":
+			r.Until, err = parseRRuleUntil(value)
+		case "BYMONTH":
+			for _, v := range strings.Split(value, ",") {
+				var m int
+				if m, err = parseRRuleInt(name, v, 1, 12); err != nil {
+					break
+				}
+				r.ByMonth = append(r.ByMonth, time.Month(m))
+			}
+		case "BYMONTHDAY":
+			for _, v := range strings.Split(value, ",") {
+				var n int
+				if n, err = parseRRuleInt(name, v, -31, 31); err != nil {
+					break
+				}
+				r.ByMonthDay = append(r.ByMonthDay, n)
+			}
+		case "BYDAY":
+			for _, v := range strings.Split(value, ",") {
+				var d RecurringDay
+				if d, err = parseRecurringDay(v); err != nil {
+					break
+				}
+				r.ByDay = append(r.ByDay, d)
+			}
+		case "BYSETPOS":
+			for _, v := range strings.Split(value, ",") {
+				var n int
+				if n, err = parseRRuleInt(name, v, -366, 366); err != nil {
+					break
+				}
+				r.BySetPos = append(r.BySetPos, n)
+			}
+		case "RSCALE":
+			if !strings.EqualFold(value, "GREGORIAN") {
+				err = fmt.Errorf("civil: unsupported RRULE calendar scale %q", value)
+			}
+		case "SKIP":
+			var p LeapDayPolicy
+			switch strings.ToUpper(value) {
+			case "OMIT":
+				p = SkipLeapDay
+			case "BACKWARD":
+				p = ObserveFeb28
+			case "FORWARD":
+				p = ObserveMar1
+			default:
+				err = fmt.Errorf("civil: invalid RRULE SKIP %q", value)
+			}
+			r.LeapDay = &p
+		case "WKST":
+			if !strings.EqualFold(value, "MO") {
+				err = fmt.Errorf("civil: unsupported RRULE week start %q", value)
+			}
+		default:
+			err = fmt.Errorf("civil: unsupported RRULE part %q", name)
+		}
+		if err != nil {
+			return RRule{}, err
+		}
+	}
+	if seen["COUNT"] && seen["UNTIL"] {
+		return RRule{}, fmt.Errorf("civil: RRULE %q has both COUNT and UNTIL", s)
+	}
+	if seen["SKIP"] && !seen["RSCALE"] {
+		return RRule{}, fmt.Errorf("civil: RRULE %q has SKIP without RSCALE", s)
+	}
+	return r, r.validate()
+}
+
+// parseRRuleInt parses the decimal value of an RRULE part, which must be
+// nonzero and lie in the range [lo, hi].
+func parseRRuleInt(name, value string, lo, hi int) (int, error) {
+	n, err := strconv.Atoi(value)
+	if err != nil || n == 0 || n < lo || n > hi {
+		return 0, fmt.Errorf("civil: invalid RRULE %s %q", name, value)
+	}
+	return n, nil
+}
+
+// parseRecurringDay parses an element of BYDAY, such as "MO" or "-1FR".
+func parseRecurringDay(s string) (RecurringDay, error) {
+	if len(s) < 2 {
+		return RecurringDay{}, fmt.Errorf("civil: invalid RRULE BYDAY %q", s)
+	}
+	w, ok := parseWeekdayCode(s[len(s)-2:])
+	if !ok {
+		return RecurringDay{}, fmt.Errorf("civil: invalid RRULE BYDAY %q", s)
+	}
+	d := RecurringDay{Weekday: w}
+	if n := s[:len(s)-2]; n != "" {
+		var err error
+		if d.N, err = parseRRuleInt("BYDAY", n, -53, 53); err != nil {
+			return RecurringDay{}, err
+		}
+	}
+	return d, nil
+}
+
+// parseRRuleUntil parses an UNTIL value, either a date in the form 20060102
+// or a datetime in the form 20060102T150405.
+func parseRRuleUntil(s string) (DateTime, error) {
+	s = strings.TrimSuffix(s, "Z")
+	layout := "20060102T150405"
+	if len(s) == len(BasicDateLayout) {
+		layout = BasicDateLayout
+	}
+	t, err := time.Parse(layout, s)
+	if err != nil {
+		return DateTime{}, fmt.Errorf("civil: invalid RRULE UNTIL %q", s)
+	}
+	return DateTimeOf(t), nil
+}
+
+// validate reports an error if the parts of r are inconsistent.
+func (r RRule) validate() error {
+	switch {
+	case r.Freq < Daily || r.Freq > Yearly:
+		return fmt.Errorf("civil: RRULE has invalid frequency %v", r.Freq)
+	case r.Interval < 0 || r.Count < 0:
+		return fmt.Errorf("civil: RRULE has negative INTERVAL or COUNT")
+	case r.Freq == Weekly && len(r.ByMonthDay) > 0:
+		return fmt.Errorf("civil: RRULE has BYMONTHDAY with FREQ=WEEKLY")
+	case len(r.BySetPos) > 0 && len(r.ByMonth)+len(r.ByMonthDay)+len(r.ByDay) == 0:
+		return fmt.Errorf("civil: RRULE has BYSETPOS without another BY part")
+	case r.LeapDay != nil && (*r.LeapDay < ObserveFeb28 || *r.LeapDay > SkipLeapDay):
+		return fmt.Errorf("civil: RRULE has invalid leap day policy %v", *r.LeapDay)
+	}
+	if r.Freq == Daily || r.Freq == Weekly {
+		for _, d := range r.ByDay {
+			if d.N != 0 {
+				return fmt.Errorf("civil: RRULE has BYDAY %v with FREQ=%s", d, strings.ToUpper(r.Freq.String()))
+			}
+		}
+	}
+	return nil
+}
+
+// String returns the rule in the form used by RFC 5545, without the
+// "RRULE:" prefix. An UNTIL at midnight is written as a date.
+func (r RRule) String() string {
+	var b strings.Builder
+	b.WriteString("FREQ=" + strings.ToUpper(r.Freq.String()))
+	if r.Interval > 1 {
+		fmt.Fprintf(&b, ";INTERVAL=%d", r.Interval)
+	}
+	if r.Count > 0 {
+		fmt.Fprintf(&b, ";COUNT=%d", r.Count)
+	}
+	if !r.Until.IsZero() {
+		layout := "20060102T150405"
+		if r.Until.Time.IsZero() {
+			layout = BasicDateLayout
+		}
+		b.WriteString(";UNTIL=" + r.Until.In(time.UTC).Format(layout))
+	}
+	writeList(&b, "BYMONTH", r.ByMonth, func(m time.Month) string { return strconv.Itoa(int(m)) })
+	writeList(&b, "BYMONTHDAY", r.ByMonthDay, strconv.Itoa)
+	writeList(&b, "BYDAY", r.ByDay, RecurringDay.String)
+	writeList(&b, "BYSETPOS", r.BySetPos, strconv.Itoa)
+	if r.LeapDay != nil {
+		skip := "OMIT"
+		switch *r.LeapDay {
+		case ObserveFeb28:
+			skip = "BACKWARD"
+		case ObserveMar1:
+			skip = "FORWARD"
+		}
+		b.WriteString(";RSCALE=GREGORIAN;SKIP=" + skip)
+	}
+	return b.String()
+}
+
+// writeList writes the RRULE part name with the comma-separated values of
+// vs, if there are any.
+func writeList[T any](b *strings.Builder, name string, vs []T, str func(T) string) {
+	for i, v := range vs {
+		if i == 0 {
+			b.WriteString(";" + name + "=")
+		} else {
+			b.WriteByte(',')
+		}
+		b.WriteString(str(v))
+	}
+}
+
+// Dates returns an iterator over the dates on which r recurs, starting at
+// start, which supplies the month, day and weekday of parts that r omits.
+// Start itself is an occurrence only if it matches the rule. The time of
+// day of Until is ignored. If r is invalid, the iterator yields nothing.
+func (r RRule) Dates(start Date) iter.Seq[Date] {
+	return func(yield func(Date) bool) {
+		past := func(d Date) bool { return !r.Until.IsZero() && d.After(r.Until.Date) }
+		r.occurrences(start, past, yield)
+	}
+}
+
+// DateTimes returns an iterator over the datetimes at which r recurs,
+// starting at start. Every occurrence has the time of day of start; the
+// dates are those of r.Dates(start.Date).
+func (r RRule) DateTimes(start DateTime) iter.Seq[DateTime] {
+	return func(yield func(DateTime) bool) {
+		past := func(d Date) bool {
+			return !r.Until.IsZero() && (DateTime{Date: d, Time: start.Time}).After(r.Until)
+		}
+		r.occurrences(start.Date, past, func(d Date) bool {
+			return yield(DateTime{Date: d, Time: start.Time})
+		})
+	}
+}
+
+// occurrences calls yield for each date on which r recurs from start until
+// yield returns false, the Count is reached, past reports true or MaxDate is
+// passed.
+func (r RRule) occurrences(start Date, past func(Date) bool, yield func(Date) bool) {
+	if r.validate() != nil {
+		return
+	}
+	interval := max(r.Interval, 1)
+	n := 0
+	var set []Date
+	var prev Date
+	for k := 0; ; k += interval {
+		first, last := r.period(start, k)
+		if first.After(MaxDate) {
+			return
+		}
+		set = r.missingDays(set[:0], start, first, last)
+		moved := len(set) > 0
+		for d := first; !d.After(last); d = d.AddDays(1) {
+			if r.matches(d, start, first, last) {
+				set = append(set, d)
+			}
+		}
+		if moved {
+			slices.SortFunc(set, Date.Compare)
+			set = slices.Compact(set)
+		}
+		// As RFC 5545 requires, BYSETPOS counts the whole period, including
+		// any dates before start.
+		for _, d := range r.setPositions(set) {
+			// A day moved forward may also be an occurrence of the next
+			// period.
+			if d.Before(start) || (n > 0 && !d.After(prev)) {
+				continue
+			}
+			prev = d
+			if past(d) || !yield(d) {
+				return
+			}
+			if n++; n == r.Count {
+				return
+			}
+		}
+	}
+}
+
+// missingDays appends to ds the dates to which r.LeapDay moves the
+// occurrences in the period from first to last that fall on a day the month
+// does not have.
+func (r RRule) missingDays(ds []Date, start, first, last Date) []Date {
+	if r.LeapDay == nil || *r.LeapDay == SkipLeapDay || len(r.ByDay) > 0 || (r.Freq != Monthly && r.Freq != Yearly) {
+		return ds
+	}
+	days := r.ByMonthDay
+	if len(days) == 0 {
+		days = []int{start.Day}
+	}
+	// The period is a month or a year, so first is the first of a month.
+	for m := first; !m.After(last); m = m.AddMonths(1) {
+		if len(r.ByMonth) > 0 && !slices.Contains(r.ByMonth, m.Month) {
+			continue
+		}
+		if r.Freq == Yearly && len(r.ByMonth) == 0 && len(r.ByMonthDay) == 0 && m.Month != start.Month {
+			continue
+		}
+		end := YearMonth{Year: m.Year, Month: m.Month}.LastDate()
+		for _, n := range days {
+			if n > end.Day {
+				if *r.LeapDay == ObserveMar1 {
+					ds = append(ds, end.AddDays(1))
+				} else {
+					ds = append(ds, end)
+				}
+				break
+			}
+		}
+	}
+	return ds
+}
+
+// setPositions returns the dates of set, the occurrences within a period,
+// at the positions of r.BySetPos, or all of them if r has no BYSETPOS.
+func (r RRule) setPositions(set []Date) []Date {
+	if len(r.BySetPos) == 0 {
+		return set
+	}
+	var selected []Date
+	for i, d := range set {
+		for _, pos := range r.BySetPos {
+			if pos == i+1 || pos == i-len(set) {
+				selected = append(selected, d)
+				break
+			}
+		}
+	}
+	return selected
+}
+
+// period returns the first and last dates of the k'th period after the one
+// containing start.
+func (r RRule) period(start Date, k int) (first, last Date) {
+	switch r.Freq {
+	case Weekly:
+		first = start.AddDays(-(int(start.Weekday())+6)%7 + 7*k)
+		return first, first.AddDays(6)
+	case Monthly:
+		ym := YearMonth{Year: start.Year, Month: start.Month}
+		first = ym.FirstDate().AddMonths(k)
+		return first, YearMonth{Year: first.Year, Month: first.Month}.LastDate()
+	case Yearly:
+		y := Year(start.Year + k)
+		return y.FirstDate(), y.LastDate()
+	}
+	first = start.AddDays(k)
+	return first, first
+}
+
+// matches reports whether d, in the period from first to last, is an
+// occurrence of r.
+func (r RRule) matches(d, start, first, last Date) bool {
+	if len(r.ByMonth) > 0 && !slices.Contains(r.ByMonth, d.Month) {
+		return false
+	}
+	if len(r.ByMonthDay) > 0 && !r.matchesMonthDay(d) {
+		return false
+	}
+	if len(r.ByDay) > 0 {
+		if r.Freq == Monthly || (r.Freq == Yearly && len(r.ByMonth) > 0) {
+			// Ordinals count within the month.
+			ym := YearMonth{Year: d.Year, Month: d.Month}
+			first, last = ym.FirstDate(), ym.LastDate()
+		}
+		return r.matchesDay(d, first, last)
+	}
+	if len(r.ByMonthDay) > 0 {
+		return true
+	}
+	switch r.Freq {
+	case Weekly:
+		return d.Weekday() == start.Weekday()
+	case Monthly:
+		return d.Day == start.Day
+	case Yearly:
+		return d.Day == start.Day && (len(r.ByMonth) > 0 || d.Month == start.Month)
+	}
+	return true
+}
+
+// matchesMonthDay reports whether d is one of the days of r.ByMonthDay.
+func (r RRule) matchesMonthDay(d Date) bool {
+	days := YearMonth{Year: d.Year, Month: d.Month}.LastDate().Day
+	for _, n := range r.ByMonthDay {
+		if n == d.Day || (n < 0 && days+1+n == d.Day) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesDay reports whether d is one of the days of r.ByDay, counting
+// ordinals within the dates from first to last.
+func (r RRule) matchesDay(d, first, last Date) bool {
+	for _, rd := range r.ByDay {
+		if d.Weekday() != rd.Weekday {
+			continue
+		}
+		switch {
+		case rd.N == 0,
+			rd.N > 0 && d.DaysSince(first)/7+1 == rd.N,
+			rd.N < 0 && last.DaysSince(d)/7+1 == -rd.N:
+			return true
+		}
+	}
+	return false
+}
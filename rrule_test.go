@@ -0,0 +1,219 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestParseRRuleRoundTrip(t *testing.T) {
+	for _, test := range []struct {
+		in, want string
+	}{
+		{"FREQ=DAILY", "FREQ=DAILY"},
+		{"RRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE", "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE"},
+		{"freq=monthly;byday=-1fr;count=12", "FREQ=MONTHLY;COUNT=12;BYDAY=-1FR"},
+		{"FREQ=YEARLY;BYMONTH=1,7;BYMONTHDAY=-1,15;UNTIL=20301231", "FREQ=YEARLY;UNTIL=20301231;BYMONTH=1,7;BYMONTHDAY=-1,15"},
+		{"FREQ=DAILY;UNTIL=20240101T093000Z", "FREQ=DAILY;UNTIL=20240101T093000"},
+		{"FREQ=MONTHLY;INTERVAL=1;WKST=MO;BYDAY=2TU", "FREQ=MONTHLY;BYDAY=2TU"},
+		{"FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1", "FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1"},
+		{"RSCALE=GREGORIAN;FREQ=YEARLY;SKIP=FORWARD", "FREQ=YEARLY;RSCALE=GREGORIAN;SKIP=FORWARD"},
+		{"FREQ=MONTHLY;RSCALE=gregorian;SKIP=omit", "FREQ=MONTHLY;RSCALE=GREGORIAN;SKIP=OMIT"},
+	} {
+		r, err := ParseRRule(test.in)
+		if err != nil {
+			t.Errorf("ParseRRule(%q): %v", test.in, err)
+			continue
+		}
+		if got := r.String(); got != test.want {
+			t.Errorf("ParseRRule(%q).String() = %q, want %q", test.in, got, test.want)
+		}
+		if _, err := ParseRRule(r.String()); err != nil {
+			t.Errorf("ParseRRule(%q): %v", r.String(), err)
+		}
+	}
+}
+
+func TestParseRRuleRejects(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"FREQ",
+		"FREQ=",
+		"FREQ=HOURLY",
+		"INTERVAL=2",
+		"FREQ=DAILY;FREQ=WEEKLY",
+		"FREQ=DAILY;INTERVAL=0",
+		"FREQ=DAILY;COUNT=-1",
+		"FREQ=DAILY;COUNT=2;UNTIL=20240101",
+		"FREQ=DAILY;UNTIL=2024-01-01",
+		"FREQ=YEARLY;BYMONTH=13",
+		"FREQ=MONTHLY;BYMONTHDAY=32",
+		"FREQ=WEEKLY;BYMONTHDAY=1",
+		"FREQ=WEEKLY;BYDAY=1MO",
+		"FREQ=MONTHLY;BYDAY=XX",
+		"FREQ=MONTHLY;BYDAY=54MO",
+		"FREQ=WEEKLY;WKST=SU",
+		"FREQ=DAILY;BYHOUR=9",
+		"FREQ=YEARLY;RSCALE=HEBREW",
+		"FREQ=YEARLY;SKIP=FORWARD",
+		"FREQ=YEARLY;RSCALE=GREGORIAN;SKIP=SIDEWAYS",
+	} {
+		if r, err := ParseRRule(s); err == nil {
+			t.Errorf("ParseRRule(%q) = %v, want error", s, r)
+		}
+	}
+}
+
+func TestRRuleDates(t *testing.T) {
+	for _, test := range []struct {
+		rule  string
+		start Date
+		want  []Date
+	}{
+		{"FREQ=DAILY;COUNT=3", Date{2024, 12, 31},
+			[]Date{{2024, 12, 31}, {2025, 1, 1}, {2025, 1, 2}}},
+		{"FREQ=DAILY;INTERVAL=10;UNTIL=20240125", Date{2024, 1, 1},
+			[]Date{{2024, 1, 1}, {2024, 1, 11}, {2024, 1, 21}}},
+		{"FREQ=WEEKLY;BYDAY=TU,TH;COUNT=4", Date{2024, 1, 4},
+			[]Date{{2024, 1, 4}, {2024, 1, 9}, {2024, 1, 11}, {2024, 1, 16}}},
+		{"FREQ=WEEKLY;INTERVAL=2;COUNT=3", Date{2024, 2, 26},
+			[]Date{{2024, 2, 26}, {2024, 3, 11}, {2024, 3, 25}}},
+		// The US Thanksgiving.
+		{"FREQ=YEARLY;BYMONTH=11;BYDAY=4TH;COUNT=3", Date{2024, 1, 1},
+			[]Date{{2024, 11, 28}, {2025, 11, 27}, {2026, 11, 26}}},
+		// Start need not be an occurrence.
+		{"FREQ=MONTHLY;BYDAY=-1FR;COUNT=3", Date{2024, 1, 1},
+			[]Date{{2024, 1, 26}, {2024, 2, 23}, {2024, 3, 29}}},
+		{"FREQ=MONTHLY;BYMONTHDAY=-1;COUNT=3", Date{2023, 12, 15},
+			[]Date{{2023, 12, 31}, {2024, 1, 31}, {2024, 2, 29}}},
+		{"FREQ=YEARLY;BYDAY=1MO;COUNT=2", Date{2024, 1, 1},
+			[]Date{{2024, 1, 1}, {2025, 1, 6}}},
+		// Days the month does not have are skipped.
+		{"FREQ=MONTHLY;COUNT=4", Date{2024, 1, 31},
+			[]Date{{2024, 1, 31}, {2024, 3, 31}, {2024, 5, 31}, {2024, 7, 31}}},
+		{"FREQ=YEARLY;COUNT=3", Date{2024, 2, 29},
+			[]Date{{2024, 2, 29}, {2028, 2, 29}, {2032, 2, 29}}},
+		// Friday the 13th.
+		{"FREQ=MONTHLY;BYDAY=FR;BYMONTHDAY=13;COUNT=3", Date{2024, 1, 1},
+			[]Date{{2024, 9, 13}, {2024, 12, 13}, {2025, 6, 13}}},
+	} {
+		if got := rruleDates(t, test.rule, test.start); !slices.Equal(got, test.want) {
+			t.Errorf("%s from %v = %v, want %v", test.rule, test.start, got, test.want)
+		}
+	}
+}
+
+func TestRRuleLeapDay(t *testing.T) {
+	for _, test := range []struct {
+		rule  string
+		start Date
+		want  []Date
+	}{
+		{"FREQ=YEARLY;COUNT=4;RSCALE=GREGORIAN;SKIP=OMIT", Date{2024, 2, 29},
+			[]Date{{2024, 2, 29}, {2028, 2, 29}, {2032, 2, 29}, {2036, 2, 29}}},
+		{"FREQ=YEARLY;COUNT=4;RSCALE=GREGORIAN;SKIP=BACKWARD", Date{2024, 2, 29},
+			[]Date{{2024, 2, 29}, {2025, 2, 28}, {2026, 2, 28}, {2027, 2, 28}}},
+		{"FREQ=YEARLY;COUNT=4;RSCALE=GREGORIAN;SKIP=FORWARD", Date{2024, 2, 29},
+			[]Date{{2024, 2, 29}, {2025, 3, 1}, {2026, 3, 1}, {2027, 3, 1}}},
+		{"FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=29;COUNT=2;RSCALE=GREGORIAN;SKIP=BACKWARD", Date{2025, 1, 1},
+			[]Date{{2025, 2, 28}, {2026, 2, 28}}},
+		{"FREQ=MONTHLY;COUNT=4;RSCALE=GREGORIAN;SKIP=BACKWARD", Date{2024, 1, 31},
+			[]Date{{2024, 1, 31}, {2024, 2, 29}, {2024, 3, 31}, {2024, 4, 30}}},
+		{"FREQ=MONTHLY;COUNT=4;RSCALE=GREGORIAN;SKIP=FORWARD", Date{2024, 1, 31},
+			[]Date{{2024, 1, 31}, {2024, 3, 1}, {2024, 3, 31}, {2024, 5, 1}}},
+		// An occurrence moved onto another is observed once.
+		{"FREQ=MONTHLY;BYMONTHDAY=1,31;COUNT=5;RSCALE=GREGORIAN;SKIP=FORWARD", Date{2024, 3, 1},
+			[]Date{{2024, 3, 1}, {2024, 3, 31}, {2024, 4, 1}, {2024, 5, 1}, {2024, 5, 31}}},
+		{"FREQ=MONTHLY;BYMONTHDAY=30,31;COUNT=3;RSCALE=GREGORIAN;SKIP=BACKWARD", Date{2023, 2, 1},
+			[]Date{{2023, 2, 28}, {2023, 3, 30}, {2023, 3, 31}}},
+		// Only the months of the rule are observed.
+		{"FREQ=MONTHLY;INTERVAL=3;COUNT=4;RSCALE=GREGORIAN;SKIP=FORWARD", Date{2024, 1, 31},
+			[]Date{{2024, 1, 31}, {2024, 5, 1}, {2024, 7, 31}, {2024, 10, 31}}},
+		{"FREQ=YEARLY;BYDAY=-1MO;BYMONTH=2;COUNT=1;RSCALE=GREGORIAN;SKIP=FORWARD", Date{2025, 1, 1},
+			[]Date{{2025, 2, 24}}},
+	} {
+		if got := rruleDates(t, test.rule, test.start); !slices.Equal(got, test.want) {
+			t.Errorf("%s from %v = %v, want %v", test.rule, test.start, got, test.want)
+		}
+	}
+
+	p := ObserveFeb28
+	r := RRule{Freq: Yearly, Count: 2, LeapDay: &p}
+	var got []DateTime
+	for dt := range r.DateTimes(DateTime{Date{2024, 2, 29}, Time{Hour: 9}}) {
+		got = append(got, dt)
+	}
+	want := []DateTime{{Date{2024, 2, 29}, Time{Hour: 9}}, {Date{2025, 2, 28}, Time{Hour: 9}}}
+	if !slices.Equal(got, want) {
+		t.Errorf("%v.DateTimes = %v, want %v", r, got, want)
+	}
+}
+
+func rruleDates(t *testing.T, s string, start Date) []Date {
+	t.Helper()
+	r, err := ParseRRule(s)
+	if err != nil {
+		t.Fatalf("ParseRRule(%q): %v", s, err)
+	}
+	var ds []Date
+	for d := range r.Dates(start) {
+		ds = append(ds, d)
+	}
+	return ds
+}
+
+func TestRRuleBySetPos(t *testing.T) {
+	for _, test := range []struct {
+		rule  string
+		start Date
+		want  []Date
+	}{
+		// The last weekday of the month.
+		{"FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1;COUNT=4", Date{2024, 1, 1},
+			[]Date{{2024, 1, 31}, {2024, 2, 29}, {2024, 3, 29}, {2024, 4, 30}}},
+		// The first and last weekdays of the month.
+		{"FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=1,-1;COUNT=4", Date{2024, 6, 1},
+			[]Date{{2024, 6, 3}, {2024, 6, 28}, {2024, 7, 1}, {2024, 7, 31}}},
+		// The second Monday of the year; the one of 2024 is before start.
+		{"FREQ=YEARLY;BYDAY=MO;BYSETPOS=2;COUNT=2", Date{2024, 2, 15},
+			[]Date{{2025, 1, 13}, {2026, 1, 12}}},
+		// The last Friday, or last day, of the month.
+		{"FREQ=MONTHLY;BYDAY=FR;BYSETPOS=-1;COUNT=2", Date{2024, 3, 1},
+			[]Date{{2024, 3, 29}, {2024, 4, 26}}},
+		{"FREQ=MONTHLY;BYMONTHDAY=-1;COUNT=3", Date{2024, 1, 15},
+			[]Date{{2024, 1, 31}, {2024, 2, 29}, {2024, 3, 31}}},
+		// A position past the end of the period selects nothing.
+		{"FREQ=MONTHLY;BYMONTHDAY=1,15;BYSETPOS=3;UNTIL=20241231", Date{2024, 1, 1}, nil},
+	} {
+		if got := rruleDates(t, test.rule, test.start); !slices.Equal(got, test.want) {
+			t.Errorf("%s from %v = %v, want %v", test.rule, test.start, got, test.want)
+		}
+	}
+}
+
+func TestParseRRuleBySetPosRejects(t *testing.T) {
+	for _, s := range []string{
+		"FREQ=MONTHLY;BYDAY=MO;BYSETPOS=0",
+		"FREQ=MONTHLY;BYDAY=MO;BYSETPOS=367",
+		"FREQ=MONTHLY;BYDAY=MO;BYSETPOS=-367",
+		"FREQ=MONTHLY;BYDAY=MO;BYSETPOS=",
+		"FREQ=MONTHLY;BYSETPOS=-1",
+	} {
+		if r, err := ParseRRule(s); err == nil {
+			t.Errorf("ParseRRule(%q) = %v, want error", s, r)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+// A Schedule describes weekly opening hours, such as those of a shop, with
+// exceptions for particular dates such as public holidays.
+type Schedule struct {
+	// Hours holds the ranges of time during which the schedule is open on
+	// each day of the week, indexed by time.Weekday. A range that crosses
+	// midnight, such as 22:00–02:00 on a Friday, continues into the early
+	// hours of the following day.
+	Hours [7][]TimeRange
+
+	// Exceptions replaces the hours of particular dates. A date whose value
+	// is empty is closed all day.
+	Exceptions map[Date][]TimeRange
+}
+
+// HoursOn returns the ranges of time during which the schedule is open on d.
+func (s *Schedule) HoursOn(d Date) []TimeRange {
+	if hours, ok := s.Exceptions[d]; ok {
+		return hours
+	}
+	return s.Hours[d.Weekday()]
+}
+
+// IsOpen reports whether the schedule is open at dt, including in a range
+// of the previous day that crosses midnight.
+func (s *Schedule) IsOpen(dt DateTime) bool {
+	for _, r := range s.HoursOn(dt.Date) {
+		if r.Contains(dt.Time) && (!r.CrossesMidnight() || !dt.Time.Before(r.Start)) {
+			return true
+		}
+	}
+	for _, r := range s.HoursOn(dt.Date.AddDays(-1)) {
+		if r.CrossesMidnight() && dt.Time.Before(r.End) {
+			return true
+		}
+	}
+	return false
+}
+
+// NextOpen returns dt if the schedule is open at dt, and otherwise the
+// next time after dt at which it opens. It reports false if the schedule
+// never opens again.
+func (s *Schedule) NextOpen(dt DateTime) (DateTime, bool) {
+	if s.IsOpen(dt) {
+		return dt, true
+	}
+	// Once past the last exception, the weekly hours repeat, so a week
+	// without an opening means there are no more.
+	limit := dt.Date
+	for d := range s.Exceptions {
+		if d.After(limit) {
+			limit = d
+		}
+	}
+	limit = limit.AddDays(7)
+	for d := dt.Date; !d.After(limit) && !d.After(MaxDate); d = d.AddDays(1) {
+		var next Time
+		found := false
+		for _, r := range s.HoursOn(d) {
+			if r.IsEmpty() || (d == dt.Date && !r.Start.After(dt.Time)) {
+				continue
+			}
+			if !found || r.Start.Before(next) {
+				next, found = r.Start, true
+			}
+		}
+		if found {
+			return DateTime{Date: d, Time: next}, true
+		}
+	}
+	return DateTime{}, false
+}
+
+// SetHours sets the hours of each of the given days of the week to hours.
+func (s *Schedule) SetHours(days WeekdaySet, hours ...TimeRange) {
+	for d := range days.All() {
+		s.Hours[d] = hours
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"testing"
+	"time"
+)
+
+func hm(h, m int) Time { return Time{Hour: h, Minute: m} }
+
+// shop returns a schedule open 09:00–12:00 and 13:00–17:00 on weekdays and
+// 10:00–14:00 on Saturdays, closed on Christmas Day 2024 and closing at
+// noon on Christmas Eve.
+func shop() *Schedule {
+	s := &Schedule{}
+	s.SetHours(WeekdaysOf(time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday),
+		TimeRange{hm(9, 0), hm(12, 0)}, TimeRange{hm(13, 0), hm(17, 0)})
+	s.SetHours(WeekdaysOf(time.Saturday), TimeRange{hm(10, 0), hm(14, 0)})
+	s.Exceptions = map[Date][]TimeRange{
+		{2024, 12, 24}: {{hm(9, 0), hm(12, 0)}},
+		{2024, 12, 25}: nil,
+	}
+	return s
+}
+
+func TestScheduleIsOpen(t *testing.T) {
+	s := shop()
+	at := func(d, h, m int) DateTime { return DateTime{Date{2024, 12, d}, hm(h, m)} }
+	for _, test := range []struct {
+		dt   DateTime
+		want bool
+	}{
+		{at(23, 8, 59), false}, // Monday
+		{at(23, 9, 0), true},
+		{at(23, 11, 59), true},
+		{at(23, 12, 0), false},
+		{at(23, 13, 0), true},
+		{at(23, 17, 0), false},
+		{at(24, 10, 0), true},  // Christmas Eve
+		{at(24, 14, 0), false}, // closes at noon
+		{at(25, 10, 0), false}, // Christmas Day
+		{at(21, 13, 59), true}, // Saturday
+		{at(21, 14, 0), false},
+		{at(22, 12, 0), false}, // Sunday
+	} {
+		if got := s.IsOpen(test.dt); got != test.want {
+			t.Errorf("IsOpen(%v) = %t, want %t", test.dt, got, test.want)
+		}
+	}
+	if got := s.HoursOn(Date{2024, 12, 25}); len(got) != 0 {
+		t.Errorf("HoursOn(2024-12-25) = %v, want none", got)
+	}
+	if got := s.HoursOn(Date{2024, 12, 26}); len(got) != 2 {
+		t.Errorf("HoursOn(2024-12-26) = %v, want the weekday hours", got)
+	}
+}
+
+func TestScheduleNextOpen(t *testing.T) {
+	s := shop()
+	at := func(d, h, m int) DateTime { return DateTime{Date{2024, 12, d}, hm(h, m)} }
+	for _, test := range []struct {
+		dt, want DateTime
+	}{
+		{at(23, 10, 30), at(23, 10, 30)}, // already open
+		{at(23, 7, 0), at(23, 9, 0)},
+		{at(23, 12, 30), at(23, 13, 0)},
+		{at(23, 17, 0), at(24, 9, 0)},
+		{at(24, 12, 0), at(26, 9, 0)}, // over Christmas Day
+		{at(21, 15, 0), at(23, 9, 0)}, // over Sunday
+	} {
+		got, ok := s.NextOpen(test.dt)
+		if !ok || got != test.want {
+			t.Errorf("NextOpen(%v) = %v, %t, want %v", test.dt, got, ok, test.want)
+		}
+	}
+
+	closed := &Schedule{Exceptions: map[Date][]TimeRange{{2024, 12, 24}: {{hm(9, 0), hm(12, 0)}}}}
+	if got, ok := closed.NextOpen(at(20, 0, 0)); !ok || got != at(24, 9, 0) {
+		t.Errorf("NextOpen with only an exception = %v, %t, want %v", got, ok, at(24, 9, 0))
+	}
+	if got, ok := closed.NextOpen(at(24, 12, 0)); ok {
+		t.Errorf("NextOpen after the last opening = %v, want none", got)
+	}
+	if got, ok := (&Schedule{}).NextOpen(at(24, 12, 0)); ok {
+		t.Errorf("NextOpen of an empty schedule = %v, want none", got)
+	}
+}
+
+func TestScheduleAcrossMidnight(t *testing.T) {
+	// A bar open 22:00–02:00 on Friday and Saturday nights.
+	s := &Schedule{}
+	s.SetHours(WeekdaysOf(time.Friday, time.Saturday), TimeRange{hm(22, 0), hm(2, 0)})
+	at := func(d, h, m int) DateTime { return DateTime{Date{2024, 12, d}, hm(h, m)} }
+	for _, test := range []struct {
+		dt   DateTime
+		want bool
+	}{
+		{at(19, 23, 0), false}, // Thursday
+		{at(20, 1, 0), false},  // Friday, after a closed Thursday
+		{at(20, 21, 59), false},
+		{at(20, 22, 0), true},
+		{at(21, 1, 59), true}, // Saturday, continuing Friday night
+		{at(21, 2, 0), false},
+		{at(21, 12, 0), false},
+		{at(21, 23, 0), true},
+		{at(22, 1, 0), true}, // Sunday, continuing Saturday night
+		{at(22, 3, 0), false},
+	} {
+		if got := s.IsOpen(test.dt); got != test.want {
+			t.Errorf("IsOpen(%v) = %t, want %t", test.dt, got, test.want)
+		}
+	}
+	for _, test := range []struct {
+		dt, want DateTime
+	}{
+		{at(21, 1, 0), at(21, 1, 0)},
+		{at(21, 3, 0), at(21, 22, 0)},
+		{at(22, 3, 0), at(27, 22, 0)},
+	} {
+		got, ok := s.NextOpen(test.dt)
+		if !ok || got != test.want {
+			t.Errorf("NextOpen(%v) = %v, %t, want %v", test.dt, got, ok, test.want)
+		}
+	}
+
+	// Closing on a Friday closes the early hours of the Saturday too.
+	s.Exceptions = map[Date][]TimeRange{{2024, 12, 20}: nil}
+	if s.IsOpen(at(21, 1, 0)) {
+		t.Errorf("IsOpen(%v) after a closed Friday = true, want false", at(21, 1, 0))
+	}
+	if !s.IsOpen(at(21, 23, 0)) {
+		t.Errorf("IsOpen(%v) after a closed Friday = false, want true", at(21, 23, 0))
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// A Span is an amount of time made of a calendar part and a clock part, such
+// as 1 month, 2 days and 3h15m. Neither a Period nor a time.Duration alone
+// can express "the same time next month plus 90 minutes".
+//
+// The Duration is applied under the package's model of exactly 24-hour days,
+// without regard to daylight saving time.
+type Span struct {
+	Period
+	time.Duration
+}
+
+// ParseSpan parses an ISO 8601 duration, such as "P1M2DT3H15M", into a
+// Span. The date part has the form accepted by ParsePeriod. The time part,
+// introduced by 'T', has the form "nHnMnS", in which each component may be
+// omitted and negative, and the seconds may have a fraction.
+func ParseSpan(s string) (Span, error) {
+	return parseISODuration(s, true)
+}
+
+// String returns the span as an ISO 8601 duration, such as "P1M2DT3H15M".
+// Zero components are omitted; the zero Span is "P0D".
+func (s Span) String() string {
+	if s.Duration == 0 {
+		return s.Period.String()
+	}
+	b := s.Period.appendISO([]byte{'P'})
+	b = append(b, 'T')
+	d := s.Duration
+	if h := d / time.Hour; h != 0 {
+		b = append(strconv.AppendInt(b, int64(h), 10), 'H')
+	}
+	if m := d % time.Hour / time.Minute; m != 0 {
+		b = append(strconv.AppendInt(b, int64(m), 10), 'M')
+	}
+	if ns := d % time.Minute; ns != 0 {
+		if ns < 0 {
+			b = append(b, '-')
+			ns = -ns
+		}
+		b = strconv.AppendInt(b, int64(ns/time.Second), 10)
+		if frac := ns % time.Second; frac != 0 {
+			f := strconv.Itoa(int(frac + time.Second))
+			b = append(b, '.')
+			b = append(b, strings.TrimRight(f[1:], "0")...)
+		}
+		b = append(b, 'S')
+	}
+	return string(b)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// The output is the result of s.String().
+func (s Span) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// The span is expected to be a string in a format accepted by ParseSpan.
+func (s *Span) UnmarshalText(data []byte) error {
+	var err error
+	*s, err = ParseSpan(string(data))
+	return err
+}
+
+// SpanBetween returns the span from a to b. The Period counts whole years,
+// months and days, and the Duration the remaining time, which is less than
+// 24 hours. If b is not before a, SpanBetween(a, b).AddTo(a) equals b.
+//
+// If b is before a, the result is the negation of SpanBetween(b, a).
+func SpanBetween(a, b DateTime) Span {
+	if b.Before(a) {
+		s := SpanBetween(b, a)
+		return Span{Period: s.Period.neg(), Duration: -s.Duration}
+	}
+	end := b.Date
+	if b.Time.Before(a.Time) {
+		end = end.AddDays(-1)
+	}
+	p := periodBetween(a.Date, end)
+	mid := DateTime{Date: a.Date.AddPeriod(p), Time: a.Time}
+	return Span{Period: p, Duration: b.Sub(mid)}
+}
+
+// AddTo returns the datetime that is the span s after dt. The Period is
+// added first, and then the Duration, as by DateTime.AddPeriodDuration.
+func (s Span) AddTo(dt DateTime) DateTime {
+	return dt.AddPeriodDuration(s.Period, s.Duration)
+}
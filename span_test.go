@@ -0,0 +1,105 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSpanBetween(t *testing.T) {
+	dt := func(y int, m time.Month, d, hh, mm int) DateTime {
+		return DateTime{Date{y, m, d}, Time{hh, mm, 0, 0}}
+	}
+	for _, test := range []struct {
+		a, b DateTime
+		want Span
+	}{
+		{dt(2024, 1, 31, 23, 0), dt(2024, 1, 31, 23, 0), Span{}},
+		{dt(2024, 1, 31, 23, 0), dt(2024, 3, 3, 1, 0), Span{Period{Months: 1}, 2 * time.Hour}},
+		{dt(2024, 3, 10, 8, 0), dt(2025, 3, 12, 7, 30), Span{Period{Years: 1, Days: 1}, 23*time.Hour + 30*time.Minute}},
+		{dt(2024, 3, 10, 8, 0), dt(2024, 3, 10, 9, 15), Span{Period{}, 75 * time.Minute}},
+		{dt(2024, 3, 10, 8, 0), dt(2024, 4, 10, 8, 0), Span{Period{Months: 1}, 0}},
+	} {
+		got := SpanBetween(test.a, test.b)
+		if got != test.want {
+			t.Errorf("SpanBetween(%v, %v) = %+v, want %+v", test.a, test.b, got, test.want)
+		}
+		if end := got.AddTo(test.a); end != test.b {
+			t.Errorf("%+v.AddTo(%v) = %v, want %v", got, test.a, end, test.b)
+		}
+		if back, want := SpanBetween(test.b, test.a), (Span{test.want.Period.neg(), -test.want.Duration}); back != want {
+			t.Errorf("SpanBetween(%v, %v) = %+v, want %+v", test.b, test.a, back, want)
+		}
+		if got.Duration < 0 || got.Duration >= 24*time.Hour {
+			t.Errorf("SpanBetween(%v, %v).Duration = %v, want in [0, 24h)", test.a, test.b, got.Duration)
+		}
+	}
+}
+
+func TestParseSpan(t *testing.T) {
+	for _, test := range []struct {
+		s    string
+		want Span
+		str  string
+	}{
+		{"P1M2DT3H15M", Span{Period{Months: 1, Days: 2}, 3*time.Hour + 15*time.Minute}, "P1M2DT3H15M"},
+		{"PT90M", Span{Period{}, 90 * time.Minute}, "PT1H30M"},
+		{"PT1.5S", Span{Period{}, 1500 * time.Millisecond}, "PT1.5S"},
+		{"PT0,25S", Span{Period{}, 250 * time.Millisecond}, "PT0.25S"},
+		{"PT0.000000001S", Span{Period{}, 1}, "PT0.000000001S"},
+		{"P1D", Span{Period{Days: 1}, 0}, "P1D"},
+		{"PT0S", Span{}, "P0D"},
+		{"PT1H-30M", Span{Period{}, 30 * time.Minute}, "PT30M"},
+		{"PT-1.5S", Span{Period{}, -1500 * time.Millisecond}, "PT-1.5S"},
+		{"-P1DT2H", Span{Period{Days: -1}, -2 * time.Hour}, "P-1DT-2H"},
+	} {
+		got, err := ParseSpan(test.s)
+		if err != nil || got != test.want {
+			t.Errorf("ParseSpan(%q) = %+v, %v, want %+v", test.s, got, err, test.want)
+			continue
+		}
+		if s := got.String(); s != test.str {
+			t.Errorf("%+v.String() = %q, want %q", got, s, test.str)
+		}
+		if back, err := ParseSpan(got.String()); err != nil || back != got {
+			t.Errorf("ParseSpan(%q) = %+v, %v, want %+v", got.String(), back, err, got)
+		}
+	}
+}
+
+func TestParseSpanRejects(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"P",
+		"PT",
+		"P1DT",
+		"PT1S1M",
+		"PT1.5H",
+		"PT1.5M",
+		"PT1D",
+		"PT1W",
+		"P1HT1H",
+		"PT1H1H",
+		"PT3000000H",
+		"PT1.S",
+		"PT.5S",
+		"PT1..5S",
+	} {
+		if got, err := ParseSpan(s); err == nil {
+			t.Errorf("ParseSpan(%q) = %+v, want error", s, got)
+		}
+	}
+}
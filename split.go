@@ -0,0 +1,78 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import "time"
+
+// The methods in this file split a DateRange into consecutive chunks that
+// together cover it. The first and last chunks are cut short where the
+// range starts or ends part of the way through a chunk. An invalid range
+// has no chunks.
+
+// SplitBy splits r at the boundaries of the calendar unit u, as defined by
+// Date.Truncate, so that each chunk lies within one week, month, quarter or
+// year. It panics if u is not a known Unit.
+func (r DateRange) SplitBy(u Unit) []DateRange {
+	months := map[Unit]int{Months: 1, Quarters: 3, Years: 12}
+	return r.split(func(d Date) Date {
+		if u == Weeks {
+			return d.Truncate(u).AddDays(7)
+		}
+		return d.Truncate(u).AddMonths(months[u])
+	})
+}
+
+// SplitByMonth splits r into chunks that each lie within one calendar month.
+func (r DateRange) SplitByMonth() []DateRange {
+	return r.SplitBy(Months)
+}
+
+// SplitByWeek splits r into chunks that each lie within one week, where
+// weeks start on the given weekday.
+func (r DateRange) SplitByWeek(start time.Weekday) []DateRange {
+	return r.split(func(d Date) Date {
+		return d.TruncateToWeek(start).AddDays(7)
+	})
+}
+
+// SplitByDays splits r into chunks of n days, counted from r.Start; the
+// last chunk may be shorter. It panics if n is less than 1.
+func (r DateRange) SplitByDays(n int) []DateRange {
+	if n < 1 {
+		panic("civil: SplitByDays with non-positive length")
+	}
+	return r.split(func(d Date) Date {
+		return d.AddDays(n)
+	})
+}
+
+// split splits r at the dates returned by next, which returns the start of
+// the chunk following the one starting at d.
+func (r DateRange) split(next func(Date) Date) []DateRange {
+	if !r.IsValid() {
+		return nil
+	}
+	var chunks []DateRange
+	for start := r.Start; !start.After(r.End); {
+		end := next(start)
+		chunk := DateRange{Start: start, End: end.AddDays(-1)}
+		if chunk.End.After(r.End) {
+			chunk.End = r.End
+		}
+		chunks = append(chunks, chunk)
+		start = end
+	}
+	return chunks
+}
@@ -0,0 +1,71 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestDateRangeSplit(t *testing.T) {
+	r := func(y1 int, m1 time.Month, d1 int, y2 int, m2 time.Month, d2 int) DateRange {
+		return DateRange{Date{y1, m1, d1}, Date{y2, m2, d2}}
+	}
+	rg := r(2024, 1, 15, 2024, 4, 10)
+	for _, test := range []struct {
+		name string
+		got  []DateRange
+		want []DateRange
+	}{
+		{"SplitByMonth", rg.SplitByMonth(), []DateRange{
+			r(2024, 1, 15, 2024, 1, 31), r(2024, 2, 1, 2024, 2, 29), r(2024, 3, 1, 2024, 3, 31), r(2024, 4, 1, 2024, 4, 10),
+		}},
+		{"SplitBy(Quarters)", rg.SplitBy(Quarters), []DateRange{
+			r(2024, 1, 15, 2024, 3, 31), r(2024, 4, 1, 2024, 4, 10),
+		}},
+		{"SplitBy(Years)", r(2023, 12, 30, 2024, 1, 2).SplitBy(Years), []DateRange{
+			r(2023, 12, 30, 2023, 12, 31), r(2024, 1, 1, 2024, 1, 2),
+		}},
+		// Weeks for SplitBy start on Monday, as for Truncate.
+		{"SplitBy(Weeks)", r(2024, 1, 3, 2024, 1, 16).SplitBy(Weeks), []DateRange{
+			r(2024, 1, 3, 2024, 1, 7), r(2024, 1, 8, 2024, 1, 14), r(2024, 1, 15, 2024, 1, 16),
+		}},
+		{"SplitByWeek(Sunday)", r(2024, 1, 3, 2024, 1, 16).SplitByWeek(time.Sunday), []DateRange{
+			r(2024, 1, 3, 2024, 1, 6), r(2024, 1, 7, 2024, 1, 13), r(2024, 1, 14, 2024, 1, 16),
+		}},
+		{"SplitByDays(10)", r(2024, 2, 25, 2024, 3, 15).SplitByDays(10), []DateRange{
+			r(2024, 2, 25, 2024, 3, 5), r(2024, 3, 6, 2024, 3, 15),
+		}},
+		{"SplitByDays(1)", r(2024, 2, 28, 2024, 3, 1).SplitByDays(1), []DateRange{
+			r(2024, 2, 28, 2024, 2, 28), r(2024, 2, 29, 2024, 2, 29), r(2024, 3, 1, 2024, 3, 1),
+		}},
+		{"single day", r(2024, 3, 31, 2024, 3, 31).SplitByMonth(), []DateRange{r(2024, 3, 31, 2024, 3, 31)}},
+		{"invalid", r(2024, 3, 2, 2024, 3, 1).SplitByMonth(), nil},
+	} {
+		if !slices.Equal(test.got, test.want) {
+			t.Errorf("%s = %v, want %v", test.name, test.got, test.want)
+		}
+	}
+}
+
+func TestSplitByDaysPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("SplitByDays(0) did not panic")
+		}
+	}()
+	DateRange{Date{2024, 1, 1}, Date{2024, 1, 31}}.SplitByDays(0)
+}
@@ -0,0 +1,175 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"time"
+)
+
+// Value implements the database/sql/driver.Valuer interface.
+// The value is the result of d.String().
+//
+// Because Value has a value receiver, database/sql passes a nil *Date as
+// NULL. Drivers that inspect arguments before database/sql converts them
+// may not; use NullDate for nullable columns in that case.
+func (d Date) Value() (driver.Value, error) {
+	return d.String(), nil
+}
+
+// Scan implements the database/sql.Scanner interface.
+// It accepts a time.Time, whose location is ignored, or a string or []byte
+// in a format accepted by ParseDate.
+func (d *Date) Scan(src any) error {
+	switch v := src.(type) {
+	case time.Time:
+		*d = DateOf(v)
+		return nil
+	case string:
+		return d.UnmarshalText([]byte(v))
+	case []byte:
+		return d.UnmarshalText(v)
+	}
+	return fmt.Errorf("civil: cannot scan %T into Date", src)
+}
+
+// Value implements the database/sql/driver.Valuer interface.
+// The value is the result of t.String().
+//
+// Because Value has a value receiver, database/sql passes a nil *Time as
+// NULL. Drivers that inspect arguments before database/sql converts them
+// may not; use NullTime for nullable columns in that case.
+func (t Time) Value() (driver.Value, error) {
+	return t.String(), nil
+}
+
+// Scan implements the database/sql.Scanner interface.
+// It accepts a time.Time, whose date and location are ignored, or a string
+// or []byte in a format accepted by ParseTime.
+func (t *Time) Scan(src any) error {
+	switch v := src.(type) {
+	case time.Time:
+		*t = TimeOf(v)
+		return nil
+	case string:
+		return t.UnmarshalText([]byte(v))
+	case []byte:
+		return t.UnmarshalText(v)
+	}
+	return fmt.Errorf("civil: cannot scan %T into Time", src)
+}
+
+// Value implements the database/sql/driver.Valuer interface.
+// The value is the result of dt.String().
+//
+// Because Value has a value receiver, database/sql passes a nil *DateTime
+// as NULL. Drivers that inspect arguments before database/sql converts them
+// may not; use NullDateTime for nullable columns in that case.
+func (dt DateTime) Value() (driver.Value, error) {
+	return dt.String(), nil
+}
+
+// Scan implements the database/sql.Scanner interface.
+// It accepts a time.Time, whose location is ignored, or a string or []byte
+// in a format accepted by ParseDateTime.
+func (dt *DateTime) Scan(src any) error {
+	switch v := src.(type) {
+	case time.Time:
+		*dt = DateTimeOf(v)
+		return nil
+	case string:
+		return dt.UnmarshalText([]byte(v))
+	case []byte:
+		return dt.UnmarshalText(v)
+	}
+	return fmt.Errorf("civil: cannot scan %T into DateTime", src)
+}
+
+// NullDate represents a Date that may be NULL. It mirrors sql.NullTime.
+type NullDate struct {
+	Date  Date
+	Valid bool // Valid is true if Date is not NULL.
+}
+
+// Value implements the database/sql/driver.Valuer interface.
+func (n NullDate) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Date.Value()
+}
+
+// Scan implements the database/sql.Scanner interface.
+func (n *NullDate) Scan(src any) error {
+	if src == nil {
+		*n = NullDate{}
+		return nil
+	}
+	err := n.Date.Scan(src)
+	n.Valid = err == nil
+	return err
+}
+
+// NullTime represents a Time that may be NULL. It mirrors sql.NullTime.
+type NullTime struct {
+	Time  Time
+	Valid bool // Valid is true if Time is not NULL.
+}
+
+// Value implements the database/sql/driver.Valuer interface.
+func (n NullTime) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Time.Value()
+}
+
+// Scan implements the database/sql.Scanner interface.
+func (n *NullTime) Scan(src any) error {
+	if src == nil {
+		*n = NullTime{}
+		return nil
+	}
+	err := n.Time.Scan(src)
+	n.Valid = err == nil
+	return err
+}
+
+// NullDateTime represents a DateTime that may be NULL. It mirrors
+// sql.NullTime.
+type NullDateTime struct {
+	DateTime DateTime
+	Valid    bool // Valid is true if DateTime is not NULL.
+}
+
+// Value implements the database/sql/driver.Valuer interface.
+func (n NullDateTime) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.DateTime.Value()
+}
+
+// Scan implements the database/sql.Scanner interface.
+func (n *NullDateTime) Scan(src any) error {
+	if src == nil {
+		*n = NullDateTime{}
+		return nil
+	}
+	err := n.DateTime.Scan(src)
+	n.Valid = err == nil
+	return err
+}
@@ -0,0 +1,97 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+	"time"
+)
+
+var (
+	_ driver.Valuer = Date{}
+	_ driver.Valuer = NullDateTime{}
+	_ sql.Scanner   = (*Time)(nil)
+	_ sql.Scanner   = (*NullDate)(nil)
+)
+
+func TestSQLValue(t *testing.T) {
+	for _, test := range []struct {
+		v    driver.Valuer
+		want driver.Value
+	}{
+		{Date{2024, 2, 29}, "2024-02-29"},
+		{Time{9, 30, 0, 5}, "09:30:00.000000005"},
+		{DateTime{Date{2024, 2, 29}, Time{Hour: 9}}, "2024-02-29T09:00:00"},
+		{NullDate{Date: Date{2024, 2, 29}, Valid: true}, "2024-02-29"},
+		{NullDate{Date: Date{2024, 2, 29}}, nil},
+		{NullTime{}, nil},
+		{NullDateTime{}, nil},
+		{NullTime{Time: Time{Hour: 23}, Valid: true}, "23:00:00"},
+	} {
+		got, err := test.v.Value()
+		if err != nil || got != test.want {
+			t.Errorf("%#v.Value() = %v, %v, want %v", test.v, got, err, test.want)
+		}
+	}
+}
+
+func TestSQLScan(t *testing.T) {
+	tm := time.Date(2024, 2, 29, 9, 30, 0, 0, time.FixedZone("", 5*3600))
+	for _, src := range []any{tm, "2024-02-29T09:30:00", []byte("2024-02-29T09:30:00")} {
+		var dt DateTime
+		if err := dt.Scan(src); err != nil || dt != (DateTime{Date{2024, 2, 29}, Time{Hour: 9, Minute: 30}}) {
+			t.Errorf("DateTime.Scan(%#v) = %v, %v", src, dt, err)
+		}
+	}
+	for _, src := range []any{tm, "2024-02-29", []byte("2024-02-29")} {
+		var d Date
+		if err := d.Scan(src); err != nil || d != (Date{2024, 2, 29}) {
+			t.Errorf("Date.Scan(%#v) = %v, %v", src, d, err)
+		}
+	}
+	for _, src := range []any{tm, "09:30:00", []byte("09:30:00")} {
+		var t0 Time
+		if err := t0.Scan(src); err != nil || t0 != (Time{Hour: 9, Minute: 30}) {
+			t.Errorf("Time.Scan(%#v) = %v, %v", src, t0, err)
+		}
+	}
+	for _, src := range []any{42, nil, "2024-02-30", 1.5} {
+		var d Date
+		if err := d.Scan(src); err == nil {
+			t.Errorf("Date.Scan(%#v) = %v, want error", src, d)
+		}
+	}
+
+	n := NullDate{Date: Date{2024, 1, 1}, Valid: true}
+	if err := n.Scan(nil); err != nil || n != (NullDate{}) {
+		t.Errorf("NullDate.Scan(nil) = %+v, %v", n, err)
+	}
+	if err := n.Scan("2024-02-29"); err != nil || n != (NullDate{Date{2024, 2, 29}, true}) {
+		t.Errorf("NullDate.Scan(2024-02-29) = %+v, %v", n, err)
+	}
+	if err := n.Scan("bad"); err == nil || n.Valid {
+		t.Errorf("NullDate.Scan(bad) = %+v, %v, want invalid and an error", n, err)
+	}
+	var nt NullTime
+	if err := nt.Scan([]byte("12:00:00")); err != nil || nt != (NullTime{Time{Hour: 12}, true}) {
+		t.Errorf("NullTime.Scan(12:00:00) = %+v, %v", nt, err)
+	}
+	var ndt NullDateTime
+	if err := ndt.Scan(nil); err != nil || ndt.Valid {
+		t.Errorf("NullDateTime.Scan(nil) = %+v, %v", ndt, err)
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"iter"
+)
+
+// DecodeDates returns an iterator over the dates in a JSON array of date
+// strings read from r, such as ["2020-02-29", "2020-03-01"]. The array is
+// decoded incrementally, so it need not fit in memory.
+//
+// If the input is not such an array, the iterator yields the zero Date and
+// the error, and stops.
+func DecodeDates(r io.Reader) iter.Seq2[Date, error] {
+	return func(yield func(Date, error) bool) {
+		dec := json.NewDecoder(r)
+		if err := expectDelim(dec, '['); err != nil {
+			yield(Date{}, err)
+			return
+		}
+		for dec.More() {
+			var d Date
+			if err := dec.Decode(&d); err != nil {
+				yield(Date{}, err)
+				return
+			}
+			if !yield(d, nil) {
+				return
+			}
+		}
+		if err := expectDelim(dec, ']'); err != nil {
+			yield(Date{}, err)
+		}
+	}
+}
+
+// expectDelim reads the next token from dec and reports an error if it is
+// not the delimiter want.
+func expectDelim(dec *json.Decoder, want json.Delim) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok != want {
+		return fmt.Errorf("civil: expected %v in JSON input, found %v", want, tok)
+	}
+	return nil
+}
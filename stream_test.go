@@ -0,0 +1,85 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDecodeDates(t *testing.T) {
+	for _, test := range []struct {
+		in   string
+		want []Date
+	}{
+		{`[]`, nil},
+		{`["2020-02-29"]`, []Date{{2020, 2, 29}}},
+		{` [ "2020-02-29" ,
+		     "2020-03-01" ] `, []Date{{2020, 2, 29}, {2020, 3, 1}}},
+	} {
+		var got []Date
+		for d, err := range DecodeDates(strings.NewReader(test.in)) {
+			if err != nil {
+				t.Fatalf("DecodeDates(%q): %v", test.in, err)
+			}
+			got = append(got, d)
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("DecodeDates(%q) = %v, want %v", test.in, got, test.want)
+		}
+	}
+}
+
+func TestDecodeDatesRejects(t *testing.T) {
+	for _, test := range []struct {
+		in   string
+		want []Date // the dates yielded before the error
+	}{
+		{``, nil},
+		{`"2020-02-29"`, nil},
+		{`{"date": "2020-02-29"}`, nil},
+		{`["2020-02-29", "2020-02-30"]`, []Date{{2020, 2, 29}}},
+		{`["2020-02-29", 42]`, []Date{{2020, 2, 29}}},
+		{`["2020-02-29"`, []Date{{2020, 2, 29}}},
+	} {
+		var got []Date
+		var err error
+		for d, e := range DecodeDates(strings.NewReader(test.in)) {
+			if e != nil {
+				if d != (Date{}) {
+					t.Errorf("DecodeDates(%q) yielded %v with an error, want the zero Date", test.in, d)
+				}
+				err = e
+				continue
+			}
+			got = append(got, d)
+		}
+		if err == nil || !reflect.DeepEqual(got, test.want) {
+			t.Errorf("DecodeDates(%q) = %v, %v, want %v and an error", test.in, got, err, test.want)
+		}
+	}
+}
+
+func TestDecodeDatesStop(t *testing.T) {
+	n := 0
+	for range DecodeDates(strings.NewReader(`["2020-02-29", "2020-02-30"]`)) {
+		n++
+		break
+	}
+	if n != 1 {
+		t.Errorf("DecodeDates yielded %d dates after break, want 1", n)
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"fmt"
+	"time"
+)
+
+// nanosPerDay is the number of nanoseconds in a civil day.
+const nanosPerDay = int64(24 * time.Hour)
+
+// DurationSinceMidnight returns the time elapsed between midnight and t.
+func (t Time) DurationSinceMidnight() time.Duration {
+	return time.Duration(t.Hour)*time.Hour +
+		time.Duration(t.Minute)*time.Minute +
+		time.Duration(t.Second)*time.Second +
+		time.Duration(t.Nanosecond)
+}
+
+// NanosecondOfDay returns the number of nanoseconds between midnight and t,
+// the representation of a time of day used by Arrow's time64[ns] and by
+// Cassandra's time type.
+func (t Time) NanosecondOfDay() int64 {
+	return int64(t.DurationSinceMidnight())
+}
+
+// SecondOfDay returns the number of whole seconds between midnight and t,
+// ignoring the nanoseconds.
+func (t Time) SecondOfDay() int {
+	return 3600*t.Hour + 60*t.Minute + t.Second
+}
+
+// TimeFromNanosOfDay returns the time of day that is n nanoseconds after
+// midnight. It is the inverse of NanosecondOfDay, and returns an error if n
+// is not in the range [0, 86400e9).
+func TimeFromNanosOfDay(n int64) (Time, error) {
+	if n < 0 || n >= nanosPerDay {
+		return Time{}, fmt.Errorf("civil: nanosecond of day %d out of range", n)
+	}
+	return timeFromNanos(n), nil
+}
+
+// TimeFromSecondsOfDay returns the time of day that is n seconds after
+// midnight. It is the inverse of SecondOfDay, and returns an error if n is
+// not in the range [0, 86400).
+func TimeFromSecondsOfDay(n int) (Time, error) {
+	if n < 0 || n >= 86400 {
+		return Time{}, fmt.Errorf("civil: second of day %d out of range", n)
+	}
+	return timeFromNanos(int64(n) * int64(time.Second)), nil
+}
+
+// Sub returns the duration t-u between two times of the same day. It is
+// negative if t is before u.
+func (t Time) Sub(u Time) time.Duration {
+	return t.DurationSinceMidnight() - u.DurationSinceMidnight()
+}
+
+// Add returns the time of day that is d after t, wrapping around midnight,
+// and the number of days by which the result has been carried: 1 if it
+// falls on the following day, -1 if on the previous day, and so on. For
+// example, 22:00 plus 3 hours is 01:00 with a carry of 1, so a shift
+// ending then ends on date.AddDays(1). t must be valid.
+func (t Time) Add(d time.Duration) (Time, int) {
+	// Split d first so that adding it cannot overflow.
+	days := int64(d) / nanosPerDay
+	ns := int64(t.DurationSinceMidnight()) + int64(d)%nanosPerDay
+	switch {
+	case ns < 0:
+		ns += nanosPerDay
+		days--
+	case ns >= nanosPerDay:
+		ns -= nanosPerDay
+		days++
+	}
+	return timeFromNanos(ns), int(days)
+}
+
+// timeFromNanos returns the time of day that is ns nanoseconds after
+// midnight. ns must be in the range [0, nanosPerDay).
+func timeFromNanos(ns int64) Time {
+	return Time{
+		Hour:       int(ns / int64(time.Hour)),
+		Minute:     int(ns / int64(time.Minute) % 60),
+		Second:     int(ns / int64(time.Second) % 60),
+		Nanosecond: int(ns % int64(time.Second)),
+	}
+}
+
+// Truncate returns the result of rounding t down to a multiple of d since
+// midnight, so that 10:37 truncated to 15 minutes is 10:30. If d <= 0,
+// Truncate returns t unchanged.
+func (t Time) Truncate(d time.Duration) Time {
+	if d <= 0 {
+		return t
+	}
+	ns := t.DurationSinceMidnight()
+	return timeFromNanos(int64(ns - ns%d))
+}
+
+// Round returns the result of rounding t to the nearest multiple of d since
+// midnight, rounding halfway values up, and the number of days by which the
+// result has been carried, as for Add. It is 1 when t rounds up to
+// midnight, as 23:55 does to the nearest 15 minutes. If d <= 0, Round
+// returns t unchanged.
+func (t Time) Round(d time.Duration) (Time, int) {
+	if d <= 0 {
+		return t, 0
+	}
+	ns := t.DurationSinceMidnight()
+	r := ns % d
+	if r+r < d {
+		return timeFromNanos(int64(ns - r)), 0
+	}
+	return t.Add(d - r)
+}
+
+// EqualAt reports whether t and u are equal when both are truncated to a
+// multiple of precision, as by Truncate, so that with a precision of
+// time.Second it ignores the nanoseconds. If precision <= 0, EqualAt
+// reports whether t == u.
+func (t Time) EqualAt(u Time, precision time.Duration) bool {
+	return t.Truncate(precision) == u.Truncate(precision)
+}
+
+// StringAt returns t in the format of Time.String, but at the given
+// precision, truncating any finer fields: "15:04" for time.Minute or
+// coarser, "15:04:05" for time.Second, and a fraction of exactly three,
+// six or nine digits for time.Millisecond, time.Microsecond and
+// time.Nanosecond. A precision between two of these uses the finer one.
+// To marshal a Time at a fixed precision, use Formatted with a layout such
+// as MillisecondsLayout.
+func (t Time) StringAt(precision time.Duration) string {
+	s := fmt.Sprintf("%02d:%02d", t.Hour, t.Minute)
+	switch {
+	case precision >= time.Minute:
+		return s
+	case precision >= time.Second:
+		return s + fmt.Sprintf(":%02d", t.Second)
+	case precision >= time.Millisecond:
+		return s + fmt.Sprintf(":%02d.%03d", t.Second, t.Nanosecond/1e6)
+	case precision >= time.Microsecond:
+		return s + fmt.Sprintf(":%02d.%06d", t.Second, t.Nanosecond/1e3)
+	}
+	return s + fmt.Sprintf(":%02d.%09d", t.Second, t.Nanosecond)
+}
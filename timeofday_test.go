@@ -0,0 +1,192 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTimeAdd(t *testing.T) {
+	for _, test := range []struct {
+		t    Time
+		d    time.Duration
+		want Time
+		days int
+	}{
+		{Time{10, 0, 0, 0}, 90 * time.Minute, Time{11, 30, 0, 0}, 0},
+		{Time{22, 0, 0, 0}, 3 * time.Hour, Time{1, 0, 0, 0}, 1},
+		{Time{1, 0, 0, 0}, -2 * time.Hour, Time{23, 0, 0, 0}, -1},
+		{Time{0, 0, 0, 0}, -1, Time{23, 59, 59, 999999999}, -1},
+		{Time{23, 59, 59, 999999999}, 1, Time{}, 1},
+		{Time{12, 0, 0, 0}, 0, Time{12, 0, 0, 0}, 0},
+		{Time{12, 0, 0, 0}, 24 * time.Hour, Time{12, 0, 0, 0}, 1},
+		{Time{12, 0, 0, 0}, 50 * time.Hour, Time{14, 0, 0, 0}, 2},
+		{Time{12, 0, 0, 0}, -50 * time.Hour, Time{10, 0, 0, 0}, -2},
+		// Adding the largest durations does not overflow.
+		{Time{}, time.Duration(1<<63 - 1), Time{23, 47, 16, 854775807}, 106751},
+		{Time{}, time.Duration(-1 << 63), Time{0, 12, 43, 145224192}, -106752},
+	} {
+		got, days := test.t.Add(test.d)
+		if got != test.want || days != test.days {
+			t.Errorf("%v.Add(%v) = %v, %d, want %v, %d", test.t, test.d, got, days, test.want, test.days)
+		}
+	}
+}
+
+func TestTimeSub(t *testing.T) {
+	for _, test := range []struct {
+		t, u  Time
+		want  time.Duration
+		since time.Duration
+	}{
+		{Time{17, 30, 0, 0}, Time{9, 0, 0, 0}, 8*time.Hour + 30*time.Minute, 17*time.Hour + 30*time.Minute},
+		{Time{9, 0, 0, 0}, Time{17, 30, 0, 0}, -8*time.Hour - 30*time.Minute, 9 * time.Hour},
+		{Time{0, 0, 0, 1}, Time{}, 1, 1},
+		{Time{23, 59, 59, 999999999}, Time{}, 24*time.Hour - 1, 24*time.Hour - 1},
+		{EndOfDay, Time{}, 24 * time.Hour, 24 * time.Hour},
+		{Time{12, 0, 0, 0}, Time{12, 0, 0, 0}, 0, 12 * time.Hour},
+	} {
+		if got := test.t.Sub(test.u); got != test.want {
+			t.Errorf("%v.Sub(%v) = %v, want %v", test.t, test.u, got, test.want)
+		}
+		if got := test.t.DurationSinceMidnight(); got != test.since {
+			t.Errorf("%v.DurationSinceMidnight() = %v, want %v", test.t, got, test.since)
+		}
+	}
+}
+
+func TestTimeTruncateRound(t *testing.T) {
+	for _, test := range []struct {
+		t        Time
+		d        time.Duration
+		truncate Time
+		round    Time
+		days     int
+	}{
+		{Time{10, 37, 0, 0}, 15 * time.Minute, Time{10, 30, 0, 0}, Time{10, 30, 0, 0}, 0},
+		{Time{10, 38, 0, 0}, 15 * time.Minute, Time{10, 30, 0, 0}, Time{10, 45, 0, 0}, 0},
+		// Halfway values round up.
+		{Time{10, 37, 30, 0}, 15 * time.Minute, Time{10, 30, 0, 0}, Time{10, 45, 0, 0}, 0},
+		{Time{23, 55, 0, 0}, 15 * time.Minute, Time{23, 45, 0, 0}, Time{}, 1},
+		{Time{12, 0, 0, 499999999}, time.Second, Time{12, 0, 0, 0}, Time{12, 0, 0, 0}, 0},
+		{Time{12, 0, 0, 500000000}, time.Second, Time{12, 0, 0, 0}, Time{12, 0, 1, 0}, 0},
+		{Time{12, 0, 0, 0}, time.Hour, Time{12, 0, 0, 0}, Time{12, 0, 0, 0}, 0},
+		// Multiples are counted from midnight, not from the hour.
+		{Time{1, 10, 0, 0}, 7 * time.Minute, Time{1, 10, 0, 0}, Time{1, 10, 0, 0}, 0},
+		{Time{1, 12, 0, 0}, 7 * time.Minute, Time{1, 10, 0, 0}, Time{1, 10, 0, 0}, 0},
+		{Time{10, 37, 0, 0}, 0, Time{10, 37, 0, 0}, Time{10, 37, 0, 0}, 0},
+		{Time{10, 37, 0, 0}, -time.Hour, Time{10, 37, 0, 0}, Time{10, 37, 0, 0}, 0},
+	} {
+		if got := test.t.Truncate(test.d); got != test.truncate {
+			t.Errorf("%v.Truncate(%v) = %v, want %v", test.t, test.d, got, test.truncate)
+		}
+		if got, days := test.t.Round(test.d); got != test.round || days != test.days {
+			t.Errorf("%v.Round(%v) = %v, %d, want %v, %d", test.t, test.d, got, days, test.round, test.days)
+		}
+	}
+}
+
+func TestTimeOfDayConversions(t *testing.T) {
+	for _, test := range []struct {
+		t       Time
+		nanos   int64
+		seconds int
+	}{
+		{Time{}, 0, 0},
+		{Time{0, 0, 1, 5}, 1e9 + 5, 1},
+		{Time{12, 34, 56, 789}, 45296e9 + 789, 45296},
+		{Time{23, 59, 59, 999999999}, 86400e9 - 1, 86399},
+	} {
+		if got := test.t.NanosecondOfDay(); got != test.nanos {
+			t.Errorf("%v.NanosecondOfDay() = %d, want %d", test.t, got, test.nanos)
+		}
+		if got := test.t.SecondOfDay(); got != test.seconds {
+			t.Errorf("%v.SecondOfDay() = %d, want %d", test.t, got, test.seconds)
+		}
+		if got, err := TimeFromNanosOfDay(test.nanos); err != nil || got != test.t {
+			t.Errorf("TimeFromNanosOfDay(%d) = %v, %v, want %v", test.nanos, got, err, test.t)
+		}
+		want := test.t
+		want.Nanosecond = 0
+		if got, err := TimeFromSecondsOfDay(test.seconds); err != nil || got != want {
+			t.Errorf("TimeFromSecondsOfDay(%d) = %v, %v, want %v", test.seconds, got, err, want)
+		}
+	}
+	for _, n := range []int64{-1, 86400e9, 1 << 62} {
+		if got, err := TimeFromNanosOfDay(n); err == nil {
+			t.Errorf("TimeFromNanosOfDay(%d) = %v, want error", n, got)
+		}
+	}
+	for _, n := range []int{-1, 86400} {
+		if got, err := TimeFromSecondsOfDay(n); err == nil {
+			t.Errorf("TimeFromSecondsOfDay(%d) = %v, want error", n, got)
+		}
+	}
+}
+
+func TestEqualAt(t *testing.T) {
+	d := Date{2024, 3, 10}
+	for _, test := range []struct {
+		t, u      Time
+		precision time.Duration
+		want      bool
+	}{
+		{Time{12, 0, 0, 123456789}, Time{12, 0, 0, 123000000}, time.Millisecond, true},
+		{Time{12, 0, 0, 123456789}, Time{12, 0, 0, 124000000}, time.Millisecond, false},
+		{Time{12, 0, 0, 123456789}, Time{12, 0, 0, 999999999}, time.Second, true},
+		{Time{12, 0, 59, 0}, Time{12, 1, 0, 0}, time.Second, false},
+		{Time{12, 0, 59, 0}, Time{12, 0, 1, 0}, time.Minute, true},
+		{Time{12, 0, 0, 1}, Time{12, 0, 0, 0}, 0, false},
+		{Time{12, 0, 0, 1}, Time{12, 0, 0, 1}, 0, true},
+	} {
+		if got := test.t.EqualAt(test.u, test.precision); got != test.want {
+			t.Errorf("%v.EqualAt(%v, %v) = %t, want %t", test.t, test.u, test.precision, got, test.want)
+		}
+		dt, du := DateTime{d, test.t}, DateTime{d, test.u}
+		if got := dt.EqualAt(du, test.precision); got != test.want {
+			t.Errorf("%v.EqualAt(%v, %v) = %t, want %t", dt, du, test.precision, got, test.want)
+		}
+	}
+	dt, du := DateTime{d, Time{}}, DateTime{d.AddDays(1), Time{}}
+	if dt.EqualAt(du, 24*time.Hour) {
+		t.Errorf("%v.EqualAt(%v, 24h) = true, want false", dt, du)
+	}
+}
+
+func TestTimeStringAt(t *testing.T) {
+	tm := Time{9, 7, 3, 120450789}
+	for _, test := range []struct {
+		precision time.Duration
+		want      string
+	}{
+		{time.Hour, "09:07"},
+		{time.Minute, "09:07"},
+		{time.Second, "09:07:03"},
+		{10 * time.Millisecond, "09:07:03.120"},
+		{time.Millisecond, "09:07:03.120"},
+		{time.Microsecond, "09:07:03.120450"},
+		{100 * time.Nanosecond, "09:07:03.120450789"},
+		{time.Nanosecond, "09:07:03.120450789"},
+		{0, "09:07:03.120450789"},
+	} {
+		if got := tm.StringAt(test.precision); got != test.want {
+			t.Errorf("%v.StringAt(%v) = %q, want %q", tm, test.precision, got, test.want)
+		}
+	}
+	if got, want := (Time{23, 0, 0, 0}).StringAt(time.Millisecond), "23:00:00.000"; got != want {
+		t.Errorf("StringAt of a whole hour = %q, want %q", got, want)
+	}
+}
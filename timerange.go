@@ -0,0 +1,177 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// A TimeRange represents the times of day from Start up to, but not
+// including, End, such as the opening hours of a shop or the window of a
+// shift. The half-open form allows adjacent ranges, such as 09:00–12:00 and
+// 12:00–17:00, to share a boundary without overlapping.
+//
+// If End is before Start, the range crosses midnight: 22:00–06:00 contains
+// the times from 22:00 to the end of the day and from midnight up to 06:00.
+type TimeRange struct {
+	Start Time // The first time in the range.
+	End   Time // The time immediately after the range.
+}
+
+// ParseTimeRange parses a string in the format START/END, with two times in
+// a format accepted by ParseTime, and returns the range it represents. END
+// may also be "24:00:00", for a range that lasts until the end of the day.
+func ParseTimeRange(s string) (TimeRange, error) {
+	start, end, ok := strings.Cut(s, "/")
+	if !ok {
+		return TimeRange{}, fmt.Errorf("civil: cannot parse %q as a time range", s)
+	}
+	var r TimeRange
+	var err error
+	if r.Start, err = ParseTime(start); err != nil {
+		return TimeRange{}, err
+	}
+	if r.End, err = (Parser{AllowEndOfDay: true}).ParseTime(end); err != nil {
+		return TimeRange{}, err
+	}
+	return r, nil
+}
+
+// String returns the range in the format START/END, using the format
+// described in Time.String.
+func (r TimeRange) String() string {
+	return r.Start.String() + "/" + r.End.String()
+}
+
+// IsValid reports whether both times are valid. End may also be EndOfDay.
+func (r TimeRange) IsValid() bool {
+	return r.Start.IsValid() && (r.End.IsValid() || r.End.IsEndOfDay())
+}
+
+// IsEmpty reports whether the range contains no times: whether Start and
+// End are the same.
+func (r TimeRange) IsEmpty() bool {
+	return r.Start == r.End
+}
+
+// CrossesMidnight reports whether the range crosses midnight, which it does
+// if End is before Start.
+func (r TimeRange) CrossesMidnight() bool {
+	return r.End.Before(r.Start)
+}
+
+// Duration returns the length of the range. The duration of 22:00–06:00 is
+// 8 hours.
+func (r TimeRange) Duration() time.Duration {
+	d := r.End.Sub(r.Start)
+	if r.CrossesMidnight() {
+		d += 24 * time.Hour
+	}
+	return d
+}
+
+// Contains reports whether t lies within the range.
+func (r TimeRange) Contains(t Time) bool {
+	if r.CrossesMidnight() {
+		return !t.Before(r.Start) || t.Before(r.End)
+	}
+	return t.IsBetween(r.Start, r.End, ClosedOpen)
+}
+
+// Overlaps reports whether r and o have at least one time in common. An
+// empty range overlaps no range, not even one that contains its Start.
+func (r TimeRange) Overlaps(o TimeRange) bool {
+	if r.IsEmpty() || o.IsEmpty() {
+		return false
+	}
+	for _, a := range r.pieces() {
+		for _, b := range o.pieces() {
+			if a.Start.Before(b.End) && b.Start.Before(a.End) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// pieces splits a range that crosses midnight into its parts before and
+// after midnight. It returns other ranges unchanged.
+func (r TimeRange) pieces() []TimeRange {
+	if !r.CrossesMidnight() {
+		return []TimeRange{r}
+	}
+	return []TimeRange{{Start: r.Start, End: EndOfDay}, {Start: MinTime, End: r.End}}
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// The output is the result of r.String().
+func (r TimeRange) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// The range is expected to be a string in a format accepted by
+// ParseTimeRange.
+func (r *TimeRange) UnmarshalText(data []byte) error {
+	var err error
+	*r, err = ParseTimeRange(string(data))
+	return err
+}
+
+// TimeRangeObject is a TimeRange that is represented in JSON as an object,
+// such as {"start":"09:00:00","end":"17:30:00"}, rather than the interval
+// string used by TimeRange.
+type TimeRangeObject TimeRange
+
+// timeRangeObject is the JSON representation of TimeRangeObject.
+type timeRangeObject struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (r TimeRangeObject) MarshalJSON() ([]byte, error) {
+	return json.Marshal(timeRangeObject{Start: r.Start.String(), End: r.End.String()})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It accepts both the object form and the interval string form.
+func (r *TimeRangeObject) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		tr, err := ParseTimeRange(s)
+		*r = TimeRangeObject(tr)
+		return err
+	}
+	return r.unmarshalObject(data)
+}
+
+// unmarshalObject parses the object form of a range, whose times are in the
+// formats accepted by ParseTimeRange.
+func (r *TimeRangeObject) unmarshalObject(data []byte) error {
+	var o timeRangeObject
+	if err := json.Unmarshal(data, &o); err != nil {
+		return err
+	}
+	tr, err := ParseTimeRange(o.Start + "/" + o.End)
+	if err != nil {
+		return err
+	}
+	*r = TimeRangeObject(tr)
+	return nil
+}
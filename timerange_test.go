@@ -0,0 +1,186 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestParseTimeRange(t *testing.T) {
+	for _, test := range []struct {
+		in   string
+		want TimeRange
+	}{
+		{"09:00:00/17:30:00", TimeRange{Time{Hour: 9}, Time{Hour: 17, Minute: 30}}},
+		{"22:00:00/06:00:00", TimeRange{Time{Hour: 22}, Time{Hour: 6}}},
+		{"18:00:00/24:00:00", TimeRange{Time{Hour: 18}, EndOfDay}},
+		{"12:00:00/12:00:00", TimeRange{Time{Hour: 12}, Time{Hour: 12}}},
+	} {
+		got, err := ParseTimeRange(test.in)
+		if err != nil {
+			t.Errorf("ParseTimeRange(%q): %v", test.in, err)
+			continue
+		}
+		if got != test.want {
+			t.Errorf("ParseTimeRange(%q) = %v, want %v", test.in, got, test.want)
+		}
+		if s := got.String(); s != test.in {
+			t.Errorf("%v.String() = %q, want %q", got, s, test.in)
+		}
+	}
+	for _, bad := range []string{"", "09:00:00", "09:00:00-17:00:00", "24:00:00/09:00:00", "09:00:00/25:00:00"} {
+		if r, err := ParseTimeRange(bad); err == nil {
+			t.Errorf("ParseTimeRange(%q) = %v, want error", bad, r)
+		}
+	}
+}
+
+func TestTimeRangeDurationContains(t *testing.T) {
+	for _, test := range []struct {
+		r    TimeRange
+		dur  time.Duration
+		in   []Time
+		out  []Time
+		over bool // whether the range crosses midnight
+	}{
+		{TimeRange{Time{Hour: 9}, Time{Hour: 17}}, 8 * time.Hour,
+			[]Time{{Hour: 9}, {Hour: 16, Minute: 59}}, []Time{{Hour: 8}, {Hour: 17}}, false},
+		{TimeRange{Time{Hour: 22}, Time{Hour: 6}}, 8 * time.Hour,
+			[]Time{{Hour: 22}, {Hour: 23, Minute: 59}, {}, {Hour: 5}}, []Time{{Hour: 6}, {Hour: 12}, {Hour: 21}}, true},
+		{TimeRange{Time{}, EndOfDay}, 24 * time.Hour,
+			[]Time{{}, {Hour: 23, Minute: 59, Second: 59}}, nil, false},
+		{TimeRange{Time{Hour: 12}, Time{Hour: 12}}, 0,
+			nil, []Time{{Hour: 12}}, false},
+	} {
+		if got := test.r.Duration(); got != test.dur {
+			t.Errorf("%v.Duration() = %v, want %v", test.r, got, test.dur)
+		}
+		if got := test.r.CrossesMidnight(); got != test.over {
+			t.Errorf("%v.CrossesMidnight() = %t, want %t", test.r, got, test.over)
+		}
+		for _, tm := range test.in {
+			if !test.r.Contains(tm) {
+				t.Errorf("%v.Contains(%v) = false, want true", test.r, tm)
+			}
+		}
+		for _, tm := range test.out {
+			if test.r.Contains(tm) {
+				t.Errorf("%v.Contains(%v) = true, want false", test.r, tm)
+			}
+		}
+	}
+}
+
+func TestTimeRangeOverlaps(t *testing.T) {
+	r := func(h1, h2 int) TimeRange { return TimeRange{Time{Hour: h1}, Time{Hour: h2}} }
+	for _, test := range []struct {
+		a, b TimeRange
+		want bool
+	}{
+		{r(9, 12), r(11, 14), true},
+		{r(9, 12), r(12, 17), false},
+		{r(9, 17), r(10, 11), true},
+		{r(22, 6), r(5, 7), true},
+		{r(22, 6), r(23, 1), true},
+		{r(22, 6), r(6, 22), false},
+		{r(22, 6), r(20, 4), true},
+		// Empty ranges overlap nothing.
+		{r(10, 10), r(9, 12), false},
+		{r(9, 12), r(10, 10), false},
+		{r(10, 10), r(10, 10), false},
+		{r(23, 23), r(22, 6), false},
+	} {
+		if got := test.a.Overlaps(test.b); got != test.want {
+			t.Errorf("%v.Overlaps(%v) = %t, want %t", test.a, test.b, got, test.want)
+		}
+		if got := test.b.Overlaps(test.a); got != test.want {
+			t.Errorf("%v.Overlaps(%v) = %t, want %t", test.b, test.a, got, test.want)
+		}
+	}
+}
+
+func TestTimeRangeIsValid(t *testing.T) {
+	for _, test := range []struct {
+		r            TimeRange
+		valid, empty bool
+	}{
+		{TimeRange{Time{Hour: 9}, Time{Hour: 17}}, true, false},
+		{TimeRange{Time{Hour: 22}, Time{Hour: 6}}, true, false},
+		{TimeRange{Time{Hour: 18}, EndOfDay}, true, false},
+		{TimeRange{Time{Hour: 12}, Time{Hour: 12}}, true, true},
+		{TimeRange{EndOfDay, Time{Hour: 6}}, false, false},
+		{TimeRange{Time{Hour: 9}, Time{Hour: 25}}, false, false},
+	} {
+		if got := test.r.IsValid(); got != test.valid {
+			t.Errorf("%v.IsValid() = %t, want %t", test.r, got, test.valid)
+		}
+		if got := test.r.IsEmpty(); got != test.empty {
+			t.Errorf("%v.IsEmpty() = %t, want %t", test.r, got, test.empty)
+		}
+	}
+}
+
+func TestTimeRangeJSON(t *testing.T) {
+	r := TimeRange{Time{Hour: 9}, Time{Hour: 17, Minute: 30}}
+	const (
+		interval = `"09:00:00/17:30:00"`
+		object   = `{"start":"09:00:00","end":"17:30:00"}`
+	)
+	for _, test := range []struct {
+		c    Codec
+		want string
+	}{
+		{Codec{}, interval},
+		{Codec{RangeForm: ObjectForm}, object},
+	} {
+		data, err := test.c.MarshalTimeRange(r)
+		if err != nil || string(data) != test.want {
+			t.Errorf("MarshalTimeRange with %v = %s, %v, want %s", test.c.RangeForm, data, err, test.want)
+		}
+	}
+	if data, err := json.Marshal(TimeRangeObject(r)); err != nil || string(data) != object {
+		t.Errorf("json.Marshal(TimeRangeObject(%v)) = %s, %v, want %s", r, data, err, object)
+	}
+
+	for _, test := range []struct {
+		c    Codec
+		data string
+		ok   bool
+	}{
+		{Codec{}, interval, true},
+		{Codec{}, object, false},
+		{Codec{RangeForm: ObjectForm}, object, true},
+		{Codec{RangeForm: ObjectForm}, interval, false},
+		{Codec{RangeForm: ObjectForm}, `{"start":"09:00:00"}`, false},
+		{Codec{RangeForm: ObjectForm}, `{"start":"09:00:00","end":"25:00:00"}`, false},
+	} {
+		var got TimeRange
+		err := test.c.UnmarshalTimeRange([]byte(test.data), &got)
+		if test.ok && (err != nil || got != r) {
+			t.Errorf("UnmarshalTimeRange(%s) with %v = %v, %v, want %v", test.data, test.c.RangeForm, got, err, r)
+		}
+		if !test.ok && err == nil {
+			t.Errorf("UnmarshalTimeRange(%s) with %v = %v, want error", test.data, test.c.RangeForm, got)
+		}
+	}
+	for _, data := range []string{interval, object} {
+		var o TimeRangeObject
+		if err := json.Unmarshal([]byte(data), &o); err != nil || TimeRange(o) != r {
+			t.Errorf("json.Unmarshal(%s) into TimeRangeObject = %v, %v, want %v", data, o, err, r)
+		}
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"fmt"
+	"time"
+)
+
+// A Unit is a calendar unit, such as a week or a month.
+type Unit int
+
+// Calendar units, in increasing order of length.
+const (
+	Weeks Unit = iota
+	Months
+	Quarters
+	Years
+)
+
+// String returns the name of the unit, such as "months".
+func (u Unit) String() string {
+	switch u {
+	case Weeks:
+		return "weeks"
+	case Months:
+		return "months"
+	case Quarters:
+		return "quarters"
+	case Years:
+		return "years"
+	}
+	return fmt.Sprintf("Unit(%d)", int(u))
+}
+
+// Truncate returns the first day of the week, month, quarter or year
+// containing d, like the date_trunc function of SQL. Weeks start on Monday,
+// as in ISO 8601; use TruncateToWeek for other start days.
+//
+// Truncate panics if u is not a known Unit.
+func (d Date) Truncate(u Unit) Date {
+	switch u {
+	case Weeks:
+		return d.TruncateToWeek(time.Monday)
+	case Months:
+		return Date{Year: d.Year, Month: d.Month, Day: 1}
+	case Quarters:
+		return Date{Year: d.Year, Month: d.Month - (d.Month-1)%3, Day: 1}
+	case Years:
+		return Date{Year: d.Year, Month: time.January, Day: 1}
+	}
+	panic(fmt.Sprintf("civil: unknown unit %v", u))
+}
+
+// TruncateToWeek returns the first day of the week containing d, where weeks
+// start on the given weekday.
+func (d Date) TruncateToWeek(start time.Weekday) Date {
+	return d.AddDays(-((int(d.Weekday()) - int(start) + 7) % 7))
+}
+
+// StartOfMonth returns the first day of the month containing d.
+func (d Date) StartOfMonth() Date {
+	return d.Truncate(Months)
+}
+
+// EndOfMonth returns the last day of the month containing d.
+func (d Date) EndOfMonth() Date {
+	return d.StartOfMonth().AddMonths(1).AddDays(-1)
+}
+
+// StartOfQuarter returns the first day of the calendar quarter containing d.
+func (d Date) StartOfQuarter() Date {
+	return d.Truncate(Quarters)
+}
+
+// EndOfQuarter returns the last day of the calendar quarter containing d.
+func (d Date) EndOfQuarter() Date {
+	return d.StartOfQuarter().AddMonths(3).AddDays(-1)
+}
+
+// StartOfYear returns January 1 of the year containing d.
+func (d Date) StartOfYear() Date {
+	return d.Truncate(Years)
+}
+
+// EndOfYear returns December 31 of the year containing d.
+func (d Date) EndOfYear() Date {
+	return Date{Year: d.Year, Month: time.December, Day: 31}
+}
+
+// StartOfWeek returns the first day of the week containing d, where weeks
+// start on firstDay. It is equivalent to TruncateToWeek.
+func (d Date) StartOfWeek(firstDay time.Weekday) Date {
+	return d.TruncateToWeek(firstDay)
+}
+
+// EndOfWeek returns the last day of the week containing d, where weeks start
+// on firstDay.
+func (d Date) EndOfWeek(firstDay time.Weekday) Date {
+	return d.StartOfWeek(firstDay).AddDays(6)
+}
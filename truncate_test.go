@@ -0,0 +1,116 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDateTruncate(t *testing.T) {
+	for _, test := range []struct {
+		d                       Date
+		week, month, quarter, y Date
+	}{
+		{Date{2024, 5, 15}, Date{2024, 5, 13}, Date{2024, 5, 1}, Date{2024, 4, 1}, Date{2024, 1, 1}},
+		{Date{2024, 5, 13}, Date{2024, 5, 13}, Date{2024, 5, 1}, Date{2024, 4, 1}, Date{2024, 1, 1}},
+		{Date{2024, 5, 19}, Date{2024, 5, 13}, Date{2024, 5, 1}, Date{2024, 4, 1}, Date{2024, 1, 1}},
+		{Date{2024, 1, 3}, Date{2024, 1, 1}, Date{2024, 1, 1}, Date{2024, 1, 1}, Date{2024, 1, 1}},
+		{Date{2025, 1, 1}, Date{2024, 12, 30}, Date{2025, 1, 1}, Date{2025, 1, 1}, Date{2025, 1, 1}},
+		{Date{2024, 12, 31}, Date{2024, 12, 30}, Date{2024, 12, 1}, Date{2024, 10, 1}, Date{2024, 1, 1}},
+	} {
+		for u, want := range map[Unit]Date{Weeks: test.week, Months: test.month, Quarters: test.quarter, Years: test.y} {
+			if got := test.d.Truncate(u); got != want {
+				t.Errorf("%v.Truncate(%v) = %v, want %v", test.d, u, got, want)
+			}
+		}
+	}
+	for _, test := range []struct {
+		d     Date
+		start time.Weekday
+		want  Date
+	}{
+		{Date{2024, 5, 15}, time.Sunday, Date{2024, 5, 12}},
+		{Date{2024, 5, 12}, time.Sunday, Date{2024, 5, 12}},
+		{Date{2024, 5, 15}, time.Saturday, Date{2024, 5, 11}},
+		{Date{2024, 5, 15}, time.Wednesday, Date{2024, 5, 15}},
+		{Date{2024, 5, 15}, time.Thursday, Date{2024, 5, 9}},
+	} {
+		if got := test.d.TruncateToWeek(test.start); got != test.want {
+			t.Errorf("%v.TruncateToWeek(%v) = %v, want %v", test.d, test.start, got, test.want)
+		}
+	}
+}
+
+func TestUnitString(t *testing.T) {
+	for u, want := range map[Unit]string{Weeks: "weeks", Months: "months", Quarters: "quarters", Years: "years", Unit(9): "Unit(9)"} {
+		if got := u.String(); got != want {
+			t.Errorf("Unit(%d).String() = %q, want %q", int(u), got, want)
+		}
+	}
+}
+
+func TestDateStartEndOf(t *testing.T) {
+	for _, test := range []struct {
+		d                   Date
+		month, endMonth     Date
+		quarter, endQuarter Date
+		year, endYear       Date
+	}{
+		{Date{2024, 2, 14}, Date{2024, 2, 1}, Date{2024, 2, 29}, Date{2024, 1, 1}, Date{2024, 3, 31}, Date{2024, 1, 1}, Date{2024, 12, 31}},
+		{Date{2023, 2, 1}, Date{2023, 2, 1}, Date{2023, 2, 28}, Date{2023, 1, 1}, Date{2023, 3, 31}, Date{2023, 1, 1}, Date{2023, 12, 31}},
+		{Date{2024, 5, 31}, Date{2024, 5, 1}, Date{2024, 5, 31}, Date{2024, 4, 1}, Date{2024, 6, 30}, Date{2024, 1, 1}, Date{2024, 12, 31}},
+		{Date{2024, 9, 30}, Date{2024, 9, 1}, Date{2024, 9, 30}, Date{2024, 7, 1}, Date{2024, 9, 30}, Date{2024, 1, 1}, Date{2024, 12, 31}},
+		{Date{2024, 12, 31}, Date{2024, 12, 1}, Date{2024, 12, 31}, Date{2024, 10, 1}, Date{2024, 12, 31}, Date{2024, 1, 1}, Date{2024, 12, 31}},
+	} {
+		for _, c := range []struct {
+			name      string
+			got, want Date
+		}{
+			{"StartOfMonth", test.d.StartOfMonth(), test.month},
+			{"EndOfMonth", test.d.EndOfMonth(), test.endMonth},
+			{"StartOfQuarter", test.d.StartOfQuarter(), test.quarter},
+			{"EndOfQuarter", test.d.EndOfQuarter(), test.endQuarter},
+			{"StartOfYear", test.d.StartOfYear(), test.year},
+			{"EndOfYear", test.d.EndOfYear(), test.endYear},
+		} {
+			if c.got != c.want {
+				t.Errorf("%v.%s() = %v, want %v", test.d, c.name, c.got, c.want)
+			}
+		}
+	}
+}
+
+func TestDateStartEndOfWeek(t *testing.T) {
+	for _, test := range []struct {
+		d          Date
+		first      time.Weekday
+		start, end Date
+	}{
+		{Date{2024, 3, 13}, time.Monday, Date{2024, 3, 11}, Date{2024, 3, 17}},
+		{Date{2024, 3, 13}, time.Sunday, Date{2024, 3, 10}, Date{2024, 3, 16}},
+		{Date{2024, 3, 13}, time.Wednesday, Date{2024, 3, 13}, Date{2024, 3, 19}},
+		{Date{2024, 3, 13}, time.Thursday, Date{2024, 3, 7}, Date{2024, 3, 13}},
+		{Date{2024, 3, 10}, time.Monday, Date{2024, 3, 4}, Date{2024, 3, 10}},
+		{Date{2025, 1, 1}, time.Saturday, Date{2024, 12, 28}, Date{2025, 1, 3}},
+	} {
+		if got := test.d.StartOfWeek(test.first); got != test.start {
+			t.Errorf("%v.StartOfWeek(%v) = %v, want %v", test.d, test.first, got, test.start)
+		}
+		if got := test.d.EndOfWeek(test.first); got != test.end {
+			t.Errorf("%v.EndOfWeek(%v) = %v, want %v", test.d, test.first, got, test.end)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import "time"
+
+// CountWeekday returns the number of dates between start and end, inclusive,
+// that fall on the weekday w. It returns 0 if end is before start. It runs
+// in constant time, however long the range.
+func CountWeekday(start, end Date, w time.Weekday) int {
+	first := daysFromCivil(start.Year, start.Month, start.Day)
+	n := daysFromCivil(end.Year, end.Month, end.Day) - first + 1
+	if n <= 0 {
+		return 0
+	}
+	// Epoch day 0, 1970-01-01, was a Thursday.
+	count := n / 7
+	if offset := floorMod(int(w)-int(time.Thursday)-first, 7); offset < n%7 {
+		count++
+	}
+	return count
+}
+
+// WeekdayOccurrenceInMonth returns which occurrence of its weekday d is
+// within its month: 1 for the first Tuesday of the month, 3 for the third,
+// and so on.
+func (d Date) WeekdayOccurrenceInMonth() int {
+	return (d.Day-1)/7 + 1
+}
+
+// NthWeekdayOfMonth returns the nth date in the given month that falls on
+// the weekday w, such as the third Thursday of November for n = 3. A
+// negative n counts from the end of the month, so that -1 is the last such
+// date. It reports false if the month has no such date, as for the fifth
+// Monday of most months, or if n is 0.
+func NthWeekdayOfMonth(year int, month time.Month, n int, w time.Weekday) (Date, bool) {
+	ym := YearMonth{Year: year, Month: month}
+	var d Date
+	switch {
+	case n > 0:
+		d = ym.FirstDate().NextOrSame(w).AddWeeks(n - 1)
+	case n < 0:
+		d = ym.LastDate().PreviousOrSame(w).AddWeeks(n + 1)
+	default:
+		return Date{}, false
+	}
+	if d.Year != year || d.Month != month {
+		return Date{}, false
+	}
+	return d, true
+}
+
+// LastWeekdayOfMonth returns the last date in the given month that falls on
+// the weekday w, such as the last Friday of the month.
+func LastWeekdayOfMonth(year int, month time.Month, w time.Weekday) Date {
+	return YearMonth{Year: year, Month: month}.LastDate().PreviousOrSame(w)
+}
+
+// Next returns the first date after d that falls on the weekday w. If d is
+// itself on w, Next returns the date a week later; use NextOrSame to return
+// d instead.
+func (d Date) Next(w time.Weekday) Date {
+	return d.AddDays(1).NextOrSame(w)
+}
+
+// NextOrSame returns the first date on or after d that falls on the weekday w.
+func (d Date) NextOrSame(w time.Weekday) Date {
+	return d.AddDays((int(w) - int(d.Weekday()) + 7) % 7)
+}
+
+// Previous returns the last date before d that falls on the weekday w. If d
+// is itself on w, Previous returns the date a week earlier; use
+// PreviousOrSame to return d instead.
+func (d Date) Previous(w time.Weekday) Date {
+	return d.AddDays(-1).PreviousOrSame(w)
+}
+
+// PreviousOrSame returns the last date on or before d that falls on the
+// weekday w.
+func (d Date) PreviousOrSame(w time.Weekday) Date {
+	return d.AddDays(-((int(d.Weekday()) - int(w) + 7) % 7))
+}
@@ -0,0 +1,179 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCountWeekday(t *testing.T) {
+	for _, test := range []struct {
+		start, end Date
+		w          time.Weekday
+		want       int
+	}{
+		{Date{2024, 1, 1}, Date{2024, 1, 31}, time.Monday, 5},
+		{Date{2024, 1, 1}, Date{2024, 1, 31}, time.Sunday, 4},
+		{Date{2024, 1, 1}, Date{2024, 12, 31}, time.Monday, 53},
+		{Date{2024, 1, 1}, Date{2024, 12, 31}, time.Wednesday, 52},
+		{Date{2024, 1, 1}, Date{2024, 1, 1}, time.Monday, 1},
+		{Date{2024, 1, 1}, Date{2024, 1, 1}, time.Tuesday, 0},
+		{Date{2024, 1, 2}, Date{2024, 1, 1}, time.Monday, 0},
+		{Date{1969, 12, 25}, Date{1970, 1, 8}, time.Thursday, 3},
+	} {
+		if got := CountWeekday(test.start, test.end, test.w); got != test.want {
+			t.Errorf("CountWeekday(%v, %v, %v) = %d, want %d", test.start, test.end, test.w, got, test.want)
+		}
+	}
+}
+
+func TestWeekdayOccurrenceInMonth(t *testing.T) {
+	for _, test := range []struct {
+		d    Date
+		want int
+	}{
+		{Date{2024, 11, 1}, 1},
+		{Date{2024, 11, 7}, 1},
+		{Date{2024, 11, 8}, 2},
+		{Date{2024, 11, 28}, 4},
+		{Date{2024, 12, 29}, 5},
+	} {
+		if got := test.d.WeekdayOccurrenceInMonth(); got != test.want {
+			t.Errorf("%v.WeekdayOccurrenceInMonth() = %d, want %d", test.d, got, test.want)
+		}
+	}
+	for _, test := range []struct {
+		d    Date
+		want time.Weekday
+	}{
+		{Date{1970, 1, 1}, time.Thursday},
+		{Date{2000, 2, 29}, time.Tuesday},
+		{Date{2024, 11, 28}, time.Thursday},
+		{MinDate, time.Monday},
+		{MaxDate, time.Friday},
+	} {
+		if got := test.d.Weekday(); got != test.want {
+			t.Errorf("%v.Weekday() = %v, want %v", test.d, got, test.want)
+		}
+		if got := (DateTime{Date: test.d, Time: Time{Hour: 23}}).Weekday(); got != test.want {
+			t.Errorf("%v.Weekday() = %v, want %v", test.d, got, test.want)
+		}
+	}
+}
+
+func TestLastWeekdayOfMonth(t *testing.T) {
+	for _, test := range []struct {
+		year  int
+		month time.Month
+		w     time.Weekday
+		want  Date
+	}{
+		{2024, time.March, time.Friday, Date{2024, 3, 29}},
+		{2024, time.May, time.Friday, Date{2024, 5, 31}},
+		// The US Memorial Day.
+		{2024, time.May, time.Monday, Date{2024, 5, 27}},
+		{2024, time.February, time.Thursday, Date{2024, 2, 29}},
+		{2023, time.February, time.Tuesday, Date{2023, 2, 28}},
+	} {
+		if got := LastWeekdayOfMonth(test.year, test.month, test.w); got != test.want {
+			t.Errorf("LastWeekdayOfMonth(%d, %v, %v) = %v, want %v", test.year, test.month, test.w, got, test.want)
+		}
+	}
+}
+
+func TestDateNextPrevious(t *testing.T) {
+	// 2024-03-13 is a Wednesday.
+	d := Date{2024, 3, 13}
+	for _, test := range []struct {
+		w                        time.Weekday
+		next, nextOrSame         Date
+		previous, previousOrSame Date
+	}{
+		{time.Wednesday, Date{2024, 3, 20}, Date{2024, 3, 13}, Date{2024, 3, 6}, Date{2024, 3, 13}},
+		{time.Thursday, Date{2024, 3, 14}, Date{2024, 3, 14}, Date{2024, 3, 7}, Date{2024, 3, 7}},
+		{time.Tuesday, Date{2024, 3, 19}, Date{2024, 3, 19}, Date{2024, 3, 12}, Date{2024, 3, 12}},
+		{time.Sunday, Date{2024, 3, 17}, Date{2024, 3, 17}, Date{2024, 3, 10}, Date{2024, 3, 10}},
+		{time.Saturday, Date{2024, 3, 16}, Date{2024, 3, 16}, Date{2024, 3, 9}, Date{2024, 3, 9}},
+	} {
+		if got := d.Next(test.w); got != test.next {
+			t.Errorf("%v.Next(%v) = %v, want %v", d, test.w, got, test.next)
+		}
+		if got := d.NextOrSame(test.w); got != test.nextOrSame {
+			t.Errorf("%v.NextOrSame(%v) = %v, want %v", d, test.w, got, test.nextOrSame)
+		}
+		if got := d.Previous(test.w); got != test.previous {
+			t.Errorf("%v.Previous(%v) = %v, want %v", d, test.w, got, test.previous)
+		}
+		if got := d.PreviousOrSame(test.w); got != test.previousOrSame {
+			t.Errorf("%v.PreviousOrSame(%v) = %v, want %v", d, test.w, got, test.previousOrSame)
+		}
+	}
+	// Crossing month and year boundaries.
+	if got, want := (Date{2024, 12, 30}).Next(time.Friday), (Date{2025, 1, 3}); got != want {
+		t.Errorf("Next across a year = %v, want %v", got, want)
+	}
+	if got, want := (Date{2024, 3, 1}).Previous(time.Thursday), (Date{2024, 2, 29}); got != want {
+		t.Errorf("Previous across a month = %v, want %v", got, want)
+	}
+}
+
+func TestNthWeekdayOfMonth(t *testing.T) {
+	for _, test := range []struct {
+		year  int
+		month time.Month
+		n     int
+		w     time.Weekday
+		want  Date
+		ok    bool
+	}{
+		// The US Thanksgiving.
+		{2024, time.November, 4, time.Thursday, Date{2024, 11, 28}, true},
+		{2024, time.November, 1, time.Friday, Date{2024, 11, 1}, true},
+		{2024, time.November, 5, time.Friday, Date{2024, 11, 29}, true},
+		{2024, time.November, 5, time.Monday, Date{}, false},
+		{2024, time.November, -1, time.Saturday, Date{2024, 11, 30}, true},
+		{2024, time.November, -2, time.Saturday, Date{2024, 11, 23}, true},
+		{2024, time.November, -5, time.Friday, Date{2024, 11, 1}, true},
+		{2024, time.November, -5, time.Monday, Date{}, false},
+		{2024, time.February, 5, time.Thursday, Date{2024, 2, 29}, true},
+		{2023, time.February, 5, time.Wednesday, Date{}, false},
+		{2024, time.November, 0, time.Friday, Date{}, false},
+	} {
+		got, ok := NthWeekdayOfMonth(test.year, test.month, test.n, test.w)
+		if got != test.want || ok != test.ok {
+			t.Errorf("NthWeekdayOfMonth(%d, %v, %d, %v) = %v, %t, want %v, %t", test.year, test.month, test.n, test.w, got, ok, test.want, test.ok)
+		}
+	}
+}
+
+func TestCountWeekdayBruteForce(t *testing.T) {
+	for start := (Date{1969, 12, 1}); start.Before(Date{1970, 2, 1}); start = start.AddDays(1) {
+		for n := -1; n < 40; n++ {
+			end := start.AddDays(n)
+			for w := time.Sunday; w <= time.Saturday; w++ {
+				want := 0
+				for d := start; !d.After(end); d = d.AddDays(1) {
+					if d.Weekday() == w {
+						want++
+					}
+				}
+				if got := CountWeekday(start, end, w); got != want {
+					t.Fatalf("CountWeekday(%v, %v, %v) = %d, want %d", start, end, w, got, want)
+				}
+			}
+		}
+	}
+}
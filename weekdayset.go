@@ -0,0 +1,134 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+	"time"
+)
+
+// A WeekdaySet is a set of days of the week.
+type WeekdaySet uint8
+
+// SaturdaySunday is the set of the days of the most common weekend.
+const SaturdaySunday WeekdaySet = 1<<time.Saturday | 1<<time.Sunday
+
+// FridaySaturday is the set of the days of the weekend observed in much of
+// the Middle East.
+const FridaySaturday WeekdaySet = 1<<time.Friday | 1<<time.Saturday
+
+// weekdayCodes are the two-letter codes of the days of the week used by
+// RFC 5545, indexed by time.Weekday.
+var weekdayCodes = [7]string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+// WeekdaysOf returns the set containing the given days.
+func WeekdaysOf(days ...time.Weekday) WeekdaySet {
+	var s WeekdaySet
+	for _, d := range days {
+		s = s.Add(d)
+	}
+	return s
+}
+
+// ParseWeekdaySet parses a comma-separated list of the two-letter codes of
+// RFC 5545, such as "MO,WE,FR". Codes are matched without regard to case,
+// and the empty string is the empty set.
+func ParseWeekdaySet(s string) (WeekdaySet, error) {
+	var set WeekdaySet
+	if s == "" {
+		return set, nil
+	}
+	for _, code := range strings.Split(s, ",") {
+		d, ok := parseWeekdayCode(strings.TrimSpace(code))
+		if !ok {
+			return 0, fmt.Errorf("civil: unknown weekday %q in %q", code, s)
+		}
+		set = set.Add(d)
+	}
+	return set, nil
+}
+
+// parseWeekdayCode returns the day of the week with the given two-letter
+// code.
+func parseWeekdayCode(code string) (time.Weekday, bool) {
+	for i, c := range weekdayCodes {
+		if strings.EqualFold(code, c) {
+			return time.Weekday(i), true
+		}
+	}
+	return 0, false
+}
+
+// Contains reports whether d is in s.
+func (s WeekdaySet) Contains(d time.Weekday) bool {
+	return s&(1<<d) != 0
+}
+
+// Add returns the set containing d and the days in s.
+func (s WeekdaySet) Add(d time.Weekday) WeekdaySet {
+	return s | 1<<d
+}
+
+// Remove returns the set containing the days in s other than d.
+func (s WeekdaySet) Remove(d time.Weekday) WeekdaySet {
+	return s &^ (1 << d)
+}
+
+// Len returns the number of days in s.
+func (s WeekdaySet) Len() int {
+	n := 0
+	for range s.All() {
+		n++
+	}
+	return n
+}
+
+// All returns an iterator over the days in s, from Monday to Sunday.
+func (s WeekdaySet) All() iter.Seq[time.Weekday] {
+	return func(yield func(time.Weekday) bool) {
+		for i := 1; i <= 7; i++ {
+			if d := time.Weekday(i % 7); s.Contains(d) && !yield(d) {
+				return
+			}
+		}
+	}
+}
+
+// String returns the days in s as a comma-separated list of the two-letter
+// codes of RFC 5545, from Monday to Sunday, such as "MO,WE,FR".
+func (s WeekdaySet) String() string {
+	var codes []string
+	for d := range s.All() {
+		codes = append(codes, weekdayCodes[d])
+	}
+	return strings.Join(codes, ",")
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// The output is the result of s.String().
+func (s WeekdaySet) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// The set is expected to be a string in a format accepted by
+// ParseWeekdaySet.
+func (s *WeekdaySet) UnmarshalText(data []byte) error {
+	var err error
+	*s, err = ParseWeekdaySet(string(data))
+	return err
+}
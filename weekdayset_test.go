@@ -0,0 +1,95 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestWeekdaySet(t *testing.T) {
+	s := WeekdaysOf(time.Monday, time.Wednesday, time.Friday)
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		want := d == time.Monday || d == time.Wednesday || d == time.Friday
+		if got := s.Contains(d); got != want {
+			t.Errorf("%v.Contains(%v) = %t, want %t", s, d, got, want)
+		}
+	}
+	if got := s.Len(); got != 3 {
+		t.Errorf("%v.Len() = %d, want 3", s, got)
+	}
+	if got, want := s.Add(time.Sunday).Remove(time.Wednesday), WeekdaysOf(time.Monday, time.Friday, time.Sunday); got != want {
+		t.Errorf("Add and Remove = %v, want %v", got, want)
+	}
+	if got := s.Add(time.Monday); got != s {
+		t.Errorf("%v.Add(Monday) = %v, want unchanged", s, got)
+	}
+	if got := s.Remove(time.Tuesday); got != s {
+		t.Errorf("%v.Remove(Tuesday) = %v, want unchanged", s, got)
+	}
+	var days []time.Weekday
+	for d := range WeekdaysOf(time.Sunday, time.Saturday, time.Monday).All() {
+		days = append(days, d)
+	}
+	if len(days) != 3 || days[0] != time.Monday || days[1] != time.Saturday || days[2] != time.Sunday {
+		t.Errorf("All() = %v, want [Monday Saturday Sunday]", days)
+	}
+}
+
+func TestParseWeekdaySet(t *testing.T) {
+	for _, test := range []struct {
+		s    string
+		want WeekdaySet
+		str  string
+	}{
+		{"", 0, ""},
+		{"MO,WE,FR", WeekdaysOf(time.Monday, time.Wednesday, time.Friday), "MO,WE,FR"},
+		{"su,sa", SaturdaySunday, "SA,SU"},
+		{"FR, SA", FridaySaturday, "FR,SA"},
+		{"MO,MO", WeekdaysOf(time.Monday), "MO"},
+		{"SU,MO,TU,WE,TH,FR,SA", 0x7f, "MO,TU,WE,TH,FR,SA,SU"},
+	} {
+		got, err := ParseWeekdaySet(test.s)
+		if err != nil || got != test.want {
+			t.Errorf("ParseWeekdaySet(%q) = %v, %v, want %v", test.s, got, err, test.want)
+			continue
+		}
+		if s := got.String(); s != test.str {
+			t.Errorf("%#x.String() = %q, want %q", uint8(got), s, test.str)
+		}
+		if back, err := ParseWeekdaySet(got.String()); err != nil || back != got {
+			t.Errorf("ParseWeekdaySet(%q) = %v, %v, want %v", got.String(), back, err, got)
+		}
+	}
+	for _, s := range []string{"MON", "MO,", ",MO", "XX", "MO;WE", "1MO"} {
+		if got, err := ParseWeekdaySet(s); err == nil {
+			t.Errorf("ParseWeekdaySet(%q) = %v, want error", s, got)
+		}
+	}
+}
+
+func TestWeekdaySetJSON(t *testing.T) {
+	type config struct{ Weekend WeekdaySet }
+	c := config{FridaySaturday}
+	data, err := json.Marshal(c)
+	if err != nil || string(data) != `{"Weekend":"FR,SA"}` {
+		t.Errorf("json.Marshal(%v) = %s, %v", c, data, err)
+	}
+	var got config
+	if err := json.Unmarshal(data, &got); err != nil || got != c {
+		t.Errorf("json.Unmarshal(%s) = %v, %v, want %v", data, got, err, c)
+	}
+}
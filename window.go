@@ -0,0 +1,77 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import "time"
+
+// The functions in this file return windows of dates relative to a
+// reference date, typically today. Functions named Last return complete
+// periods before the period containing the reference date; functions named
+// ToDate return the period containing it, up to and including the reference
+// date.
+
+// LastDays returns the n complete days before ref, excluding ref itself.
+func LastDays(ref Date, n int) DateRange {
+	return DateRange{Start: ref.AddDays(-n), End: ref.AddDays(-1)}
+}
+
+// TrailingDays returns the n days ending with ref, including ref itself.
+func TrailingDays(ref Date, n int) DateRange {
+	return DateRange{Start: ref.AddDays(1 - n), End: ref}
+}
+
+// LastWeeks returns the n complete weeks before the week containing ref,
+// where weeks start on the given weekday.
+func LastWeeks(ref Date, n int, start time.Weekday) DateRange {
+	first := ref.TruncateToWeek(start)
+	return DateRange{Start: first.AddDays(-7 * n), End: first.AddDays(-1)}
+}
+
+// LastMonths returns the n complete calendar months before the month
+// containing ref.
+func LastMonths(ref Date, n int) DateRange {
+	first := ref.Truncate(Months)
+	return DateRange{Start: first.AddMonths(-n), End: first.AddDays(-1)}
+}
+
+// LastQuarters returns the n complete calendar quarters before the quarter
+// containing ref.
+func LastQuarters(ref Date, n int) DateRange {
+	first := ref.Truncate(Quarters)
+	return DateRange{Start: first.AddMonths(-3 * n), End: first.AddDays(-1)}
+}
+
+// WeekToDate returns the dates from the start of the week containing ref
+// through ref, where weeks start on the given weekday.
+func WeekToDate(ref Date, start time.Weekday) DateRange {
+	return DateRange{Start: ref.TruncateToWeek(start), End: ref}
+}
+
+// MonthToDate returns the dates from the first day of ref's month through
+// ref.
+func MonthToDate(ref Date) DateRange {
+	return DateRange{Start: ref.Truncate(Months), End: ref}
+}
+
+// QuarterToDate returns the dates from the first day of ref's quarter
+// through ref.
+func QuarterToDate(ref Date) DateRange {
+	return DateRange{Start: ref.Truncate(Quarters), End: ref}
+}
+
+// YearToDate returns the dates from January 1 of ref's year through ref.
+func YearToDate(ref Date) DateRange {
+	return DateRange{Start: ref.Truncate(Years), End: ref}
+}
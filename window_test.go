@@ -0,0 +1,52 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWindows(t *testing.T) {
+	ref := Date{2024, 3, 13} // a Wednesday
+	r := func(y1 int, m1 time.Month, d1 int, y2 int, m2 time.Month, d2 int) DateRange {
+		return DateRange{Date{y1, m1, d1}, Date{y2, m2, d2}}
+	}
+	for _, test := range []struct {
+		name string
+		got  DateRange
+		want DateRange
+	}{
+		{"LastDays(7)", LastDays(ref, 7), r(2024, 3, 6, 2024, 3, 12)},
+		{"LastDays(1)", LastDays(ref, 1), r(2024, 3, 12, 2024, 3, 12)},
+		{"TrailingDays(7)", TrailingDays(ref, 7), r(2024, 3, 7, 2024, 3, 13)},
+		{"TrailingDays(1)", TrailingDays(ref, 1), r(2024, 3, 13, 2024, 3, 13)},
+		{"LastWeeks(2, Monday)", LastWeeks(ref, 2, time.Monday), r(2024, 2, 26, 2024, 3, 10)},
+		{"LastWeeks(1, Sunday)", LastWeeks(ref, 1, time.Sunday), r(2024, 3, 3, 2024, 3, 9)},
+		{"LastMonths(2)", LastMonths(ref, 2), r(2024, 1, 1, 2024, 2, 29)},
+		{"LastMonths(3) from May 31", LastMonths(Date{2024, 5, 31}, 3), r(2024, 2, 1, 2024, 4, 30)},
+		{"LastQuarters(1)", LastQuarters(ref, 1), r(2023, 10, 1, 2023, 12, 31)},
+		{"LastQuarters(4)", LastQuarters(ref, 4), r(2023, 1, 1, 2023, 12, 31)},
+		{"WeekToDate(Monday)", WeekToDate(ref, time.Monday), r(2024, 3, 11, 2024, 3, 13)},
+		{"WeekToDate(Wednesday)", WeekToDate(ref, time.Wednesday), r(2024, 3, 13, 2024, 3, 13)},
+		{"MonthToDate", MonthToDate(ref), r(2024, 3, 1, 2024, 3, 13)},
+		{"QuarterToDate", QuarterToDate(ref), r(2024, 1, 1, 2024, 3, 13)},
+		{"YearToDate", YearToDate(ref), r(2024, 1, 1, 2024, 3, 13)},
+	} {
+		if test.got != test.want {
+			t.Errorf("%s = %v, want %v", test.name, test.got, test.want)
+		}
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"fmt"
+	"time"
+)
+
+// The methods in this file return a copy of a value with one field
+// replaced, and an error if the result is not valid. Unlike assigning to
+// the field directly, they never produce a value such as February 30.
+
+// WithYear returns d with its year set to year.
+func (d Date) WithYear(year int) (Date, error) {
+	d.Year = year
+	return d.checked()
+}
+
+// WithMonth returns d with its month set to month.
+func (d Date) WithMonth(month time.Month) (Date, error) {
+	d.Month = month
+	return d.checked()
+}
+
+// WithDay returns d with its day of the month set to day.
+func (d Date) WithDay(day int) (Date, error) {
+	d.Day = day
+	return d.checked()
+}
+
+// checked returns d, or an error if d is not valid.
+func (d Date) checked() (Date, error) {
+	if !d.IsValid() {
+		return Date{}, fmt.Errorf("civil: invalid date %v", d)
+	}
+	return d, nil
+}
+
+// WithHour returns t with its hour set to hour.
+func (t Time) WithHour(hour int) (Time, error) {
+	t.Hour = hour
+	return t.checked()
+}
+
+// WithMinute returns t with its minute set to minute.
+func (t Time) WithMinute(minute int) (Time, error) {
+	t.Minute = minute
+	return t.checked()
+}
+
+// WithSecond returns t with its second set to second.
+func (t Time) WithSecond(second int) (Time, error) {
+	t.Second = second
+	return t.checked()
+}
+
+// WithNanosecond returns t with its nanosecond set to nanosecond.
+func (t Time) WithNanosecond(nanosecond int) (Time, error) {
+	t.Nanosecond = nanosecond
+	return t.checked()
+}
+
+// checked returns t, or an error if t is not valid.
+func (t Time) checked() (Time, error) {
+	if !t.IsValid() {
+		return Time{}, fmt.Errorf("civil: invalid time %v", t)
+	}
+	return t, nil
+}
+
+// WithDate returns dt with its date set to d.
+func (dt DateTime) WithDate(d Date) (DateTime, error) {
+	d, err := d.checked()
+	if err != nil {
+		return DateTime{}, err
+	}
+	dt.Date = d
+	return dt, nil
+}
+
+// WithTime returns dt with its time of day set to t.
+func (dt DateTime) WithTime(t Time) (DateTime, error) {
+	t, err := t.checked()
+	if err != nil {
+		return DateTime{}, err
+	}
+	dt.Time = t
+	return dt, nil
+}
@@ -0,0 +1,84 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDateWith(t *testing.T) {
+	d := Date{2024, 1, 31}
+	for _, test := range []struct {
+		name    string
+		got     func() (Date, error)
+		want    Date
+		wantErr bool
+	}{
+		{"WithYear(2023)", func() (Date, error) { return d.WithYear(2023) }, Date{2023, 1, 31}, false},
+		{"WithMonth(March)", func() (Date, error) { return d.WithMonth(time.March) }, Date{2024, 3, 31}, false},
+		{"WithMonth(February)", func() (Date, error) { return d.WithMonth(time.February) }, Date{}, true},
+		{"WithMonth(13)", func() (Date, error) { return d.WithMonth(13) }, Date{}, true},
+		{"WithDay(1)", func() (Date, error) { return d.WithDay(1) }, Date{2024, 1, 1}, false},
+		{"WithDay(0)", func() (Date, error) { return d.WithDay(0) }, Date{}, true},
+		{"WithDay(32)", func() (Date, error) { return d.WithDay(32) }, Date{}, true},
+		{"WithYear(2023) of a leap day", func() (Date, error) { return Date{2024, 2, 29}.WithYear(2023) }, Date{}, true},
+	} {
+		got, err := test.got()
+		if got != test.want || (err != nil) != test.wantErr {
+			t.Errorf("%v.%s = %v, %v, want %v, error %t", d, test.name, got, err, test.want, test.wantErr)
+		}
+	}
+}
+
+func TestTimeWith(t *testing.T) {
+	tm := Time{12, 30, 15, 500}
+	for _, test := range []struct {
+		name    string
+		got     func() (Time, error)
+		want    Time
+		wantErr bool
+	}{
+		{"WithHour(23)", func() (Time, error) { return tm.WithHour(23) }, Time{23, 30, 15, 500}, false},
+		{"WithHour(24)", func() (Time, error) { return tm.WithHour(24) }, Time{}, true},
+		{"WithMinute(0)", func() (Time, error) { return tm.WithMinute(0) }, Time{12, 0, 15, 500}, false},
+		{"WithMinute(60)", func() (Time, error) { return tm.WithMinute(60) }, Time{}, true},
+		{"WithSecond(59)", func() (Time, error) { return tm.WithSecond(59) }, Time{12, 30, 59, 500}, false},
+		{"WithSecond(-1)", func() (Time, error) { return tm.WithSecond(-1) }, Time{}, true},
+		{"WithNanosecond(999999999)", func() (Time, error) { return tm.WithNanosecond(999999999) }, Time{12, 30, 15, 999999999}, false},
+		{"WithNanosecond(1e9)", func() (Time, error) { return tm.WithNanosecond(1e9) }, Time{}, true},
+	} {
+		got, err := test.got()
+		if got != test.want || (err != nil) != test.wantErr {
+			t.Errorf("%v.%s = %v, %v, want %v, error %t", tm, test.name, got, err, test.want, test.wantErr)
+		}
+	}
+}
+
+func TestDateTimeWith(t *testing.T) {
+	dt := DateTime{Date{2024, 3, 10}, Time{9, 0, 0, 0}}
+	if got, err := dt.WithDate(Date{2025, 7, 4}); err != nil || got != (DateTime{Date{2025, 7, 4}, Time{9, 0, 0, 0}}) {
+		t.Errorf("%v.WithDate(2025-07-04) = %v, %v", dt, got, err)
+	}
+	if got, err := dt.WithDate(Date{2025, 2, 29}); err == nil {
+		t.Errorf("%v.WithDate(2025-02-29) = %v, want error", dt, got)
+	}
+	if got, err := dt.WithTime(Time{17, 45, 0, 0}); err != nil || got != (DateTime{Date{2024, 3, 10}, Time{17, 45, 0, 0}}) {
+		t.Errorf("%v.WithTime(17:45) = %v, %v", dt, got, err)
+	}
+	if got, err := dt.WithTime(Time{25, 0, 0, 0}); err == nil {
+		t.Errorf("%v.WithTime(25:00) = %v, want error", dt, got)
+	}
+}
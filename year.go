@@ -0,0 +1,126 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"fmt"
+	"iter"
+	"time"
+)
+
+// A Year represents a year of the proleptic Gregorian calendar.
+type Year int
+
+// YearOf returns the Year in which d occurs.
+func YearOf(d Date) Year {
+	return Year(d.Year)
+}
+
+// String returns the year as at least four decimal digits, as in an
+// RFC3339 full-date.
+func (y Year) String() string {
+	return fmt.Sprintf("%04d", int(y))
+}
+
+// IsLeap reports whether y is a leap year.
+func (y Year) IsLeap() bool {
+	return y%4 == 0 && (y%100 != 0 || y%400 == 0)
+}
+
+// Days returns the number of days in y: 366 in a leap year, 365 otherwise.
+func (y Year) Days() int {
+	if y.IsLeap() {
+		return 366
+	}
+	return 365
+}
+
+// IsLeapYear reports whether year is a leap year of the proleptic Gregorian
+// calendar: divisible by 4, but not by 100 unless also by 400.
+func IsLeapYear(year int) bool {
+	return Year(year).IsLeap()
+}
+
+// DaysInYear returns the number of days in year: 366 in a leap year, 365
+// otherwise.
+func DaysInYear(year int) int {
+	return Year(year).Days()
+}
+
+// DaysInMonth returns the number of days in the given month of year, from
+// 28 to 31. A month outside [January, December] is normalized as by
+// time.Date, so that month 13 is January of the following year.
+func DaysInMonth(year int, month time.Month) int {
+	return daysFromCivil(year, month+1, 1) - daysFromCivil(year, month, 1)
+}
+
+// DaysInMonth returns the number of days in the month in which d occurs.
+func (d Date) DaysInMonth() int {
+	return DaysInMonth(d.Year, d.Month)
+}
+
+// FirstDate returns January 1 of y.
+func (y Year) FirstDate() Date {
+	return Date{Year: int(y), Month: time.January, Day: 1}
+}
+
+// LastDate returns December 31 of y.
+func (y Year) LastDate() Date {
+	return Date{Year: int(y), Month: time.December, Day: 31}
+}
+
+// Contains reports whether d falls in y.
+func (y Year) Contains(d Date) bool {
+	return d.Year == int(y)
+}
+
+// AddYears returns the year that is n years after y. n may be negative.
+func (y Year) AddYears(n int) Year {
+	return y + Year(n)
+}
+
+// YearsSince returns the signed number of years from s to y.
+func (y Year) YearsSince(s Year) int {
+	return int(y - s)
+}
+
+// ISOWeeks returns the number of weeks in the ISO 8601 week-numbering year
+// y: 52 or 53.
+func (y Year) ISOWeeks() int {
+	_, w := y.LastDate().AddDays(-3).ISOWeek()
+	return w
+}
+
+// Months returns an iterator over the months of y, from January to December.
+func (y Year) Months() iter.Seq[YearMonth] {
+	return func(yield func(YearMonth) bool) {
+		for m := time.January; m <= time.December; m++ {
+			if !yield(YearMonth{Year: int(y), Month: m}) {
+				return
+			}
+		}
+	}
+}
+
+// Quarters returns an iterator over the quarters of y, from Q1 to Q4.
+func (y Year) Quarters() iter.Seq[Quarter] {
+	return func(yield func(Quarter) bool) {
+		for q := 1; q <= 4; q++ {
+			if !yield(Quarter{Year: int(y), Quarter: q}) {
+				return
+			}
+		}
+	}
+}
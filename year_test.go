@@ -0,0 +1,163 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"testing"
+	"time"
+)
+
+func TestYear(t *testing.T) {
+	for _, test := range []struct {
+		y     Year
+		str   string
+		leap  bool
+		days  int
+		first Date
+		last  Date
+	}{
+		{2024, "2024", true, 366, Date{2024, 1, 1}, Date{2024, 12, 31}},
+		{2023, "2023", false, 365, Date{2023, 1, 1}, Date{2023, 12, 31}},
+		{1900, "1900", false, 365, Date{1900, 1, 1}, Date{1900, 12, 31}},
+		{2000, "2000", true, 366, Date{2000, 1, 1}, Date{2000, 12, 31}},
+		{33, "0033", false, 365, Date{33, 1, 1}, Date{33, 12, 31}},
+	} {
+		if got := test.y.String(); got != test.str {
+			t.Errorf("Year(%d).String() = %q, want %q", int(test.y), got, test.str)
+		}
+		if got := test.y.IsLeap(); got != test.leap {
+			t.Errorf("%v.IsLeap() = %t, want %t", test.y, got, test.leap)
+		}
+		if got := test.y.Days(); got != test.days {
+			t.Errorf("%v.Days() = %d, want %d", test.y, got, test.days)
+		}
+		if got := test.y.FirstDate(); got != test.first {
+			t.Errorf("%v.FirstDate() = %v, want %v", test.y, got, test.first)
+		}
+		if got := test.y.LastDate(); got != test.last {
+			t.Errorf("%v.LastDate() = %v, want %v", test.y, got, test.last)
+		}
+		if got := YearOf(test.last); got != test.y {
+			t.Errorf("YearOf(%v) = %v, want %v", test.last, got, test.y)
+		}
+	}
+}
+
+func TestYearIterators(t *testing.T) {
+	var months []YearMonth
+	for ym := range Year(2024).Months() {
+		months = append(months, ym)
+	}
+	if len(months) != 12 || months[0] != (YearMonth{2024, time.January}) || months[11] != (YearMonth{2024, time.December}) {
+		t.Errorf("Year(2024).Months() = %v", months)
+	}
+	var quarters []Quarter
+	for q := range Year(2024).Quarters() {
+		quarters = append(quarters, q)
+		if q.Quarter == 2 {
+			break
+		}
+	}
+	if len(quarters) != 2 || quarters[0] != (Quarter{2024, 1}) || quarters[1] != (Quarter{2024, 2}) {
+		t.Errorf("Year(2024).Quarters() stopped early = %v", quarters)
+	}
+}
+
+func TestYearArithmetic(t *testing.T) {
+	y := Year(2024)
+	for _, test := range []struct {
+		d    Date
+		want bool
+	}{
+		{Date{2024, 1, 1}, true},
+		{Date{2024, 12, 31}, true},
+		{Date{2023, 12, 31}, false},
+		{Date{2025, 1, 1}, false},
+	} {
+		if got := y.Contains(test.d); got != test.want {
+			t.Errorf("%v.Contains(%v) = %t, want %t", y, test.d, got, test.want)
+		}
+	}
+	for _, n := range []int{0, 1, -1, 76, -2024} {
+		got := y.AddYears(n)
+		if int(got) != 2024+n {
+			t.Errorf("%v.AddYears(%d) = %v, want %d", y, n, got, 2024+n)
+		}
+		if s := got.YearsSince(y); s != n {
+			t.Errorf("%v.YearsSince(%v) = %d, want %d", got, y, s, n)
+		}
+	}
+}
+
+func TestYearISOWeeks(t *testing.T) {
+	for y, want := range map[Year]int{
+		2004: 53, 2009: 53, 2015: 53, 2020: 53, 2026: 53, 2032: 53,
+		2019: 52, 2021: 52, 2023: 52, 2024: 52, 2025: 52, 2100: 52,
+	} {
+		if got := y.ISOWeeks(); got != want {
+			t.Errorf("%v.ISOWeeks() = %d, want %d", y, got, want)
+		}
+		if got := (ISOWeek{int(y), want}).IsValid(); !got {
+			t.Errorf("ISOWeek{%v, %d}.IsValid() = false", y, want)
+		}
+	}
+}
+
+func TestDaysIn(t *testing.T) {
+	for _, test := range []struct {
+		year int
+		leap bool
+	}{
+		{2024, true},
+		{2023, false},
+		{2000, true},
+		{1900, false},
+		{2100, false},
+		{1600, true},
+	} {
+		if got := IsLeapYear(test.year); got != test.leap {
+			t.Errorf("IsLeapYear(%d) = %t, want %t", test.year, got, test.leap)
+		}
+		want := 365
+		if test.leap {
+			want = 366
+		}
+		if got := DaysInYear(test.year); got != want {
+			t.Errorf("DaysInYear(%d) = %d, want %d", test.year, got, want)
+		}
+	}
+	for _, test := range []struct {
+		year  int
+		month time.Month
+		want  int
+	}{
+		{2024, time.January, 31},
+		{2024, time.February, 29},
+		{2023, time.February, 28},
+		{1900, time.February, 28},
+		{2024, time.April, 30},
+		{2024, time.December, 31},
+		// Months outside the year are normalized.
+		{2023, 14, 29},
+		{2024, 0, 31},
+	} {
+		if got := DaysInMonth(test.year, test.month); got != test.want {
+			t.Errorf("DaysInMonth(%d, %d) = %d, want %d", test.year, test.month, got, test.want)
+		}
+	}
+	if got := (Date{2024, 2, 10}).DaysInMonth(); got != 29 {
+		t.Errorf("Date.DaysInMonth() = %d, want 29", got)
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"fmt"
+	"time"
+)
+
+// A YearMonth represents a month of a particular year, without a day.
+type YearMonth struct {
+	Year  int        // Year (e.g., 2014).
+	Month time.Month // Month of the year (January = 1, ...).
+}
+
+// String returns the month in the format YYYY-MM.
+func (ym YearMonth) String() string {
+	return fmt.Sprintf("%04d-%02d", ym.Year, ym.Month)
+}
+
+// IsValid reports whether the month is valid.
+func (ym YearMonth) IsValid() bool {
+	return time.January <= ym.Month && ym.Month <= time.December
+}
+
+// FirstDate returns the first day of the month.
+func (ym YearMonth) FirstDate() Date {
+	return Date{Year: ym.Year, Month: ym.Month, Day: 1}
+}
+
+// LastDate returns the last day of the month.
+func (ym YearMonth) LastDate() Date {
+	return ym.FirstDate().AddMonths(1).AddDays(-1)
+}
+
+// Grid returns the dates of the weeks that overlap the month, as the rows
+// of a calendar with weeks starting on weekStart. The first and last rows
+// include the days of the adjacent months needed to fill them. A month has
+// four to six rows; to draw every month with six, extend the result with
+// the weeks that follow.
+func (ym YearMonth) Grid(weekStart time.Weekday) [][7]Date {
+	var rows [][7]Date
+	last := ym.LastDate()
+	for d := ym.FirstDate().TruncateToWeek(weekStart); !d.After(last); {
+		var row [7]Date
+		for i := range row {
+			row[i] = d
+			d = d.AddDays(1)
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
@@ -0,0 +1,92 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"testing"
+	"time"
+)
+
+func TestYearMonth(t *testing.T) {
+	for _, test := range []struct {
+		ym          YearMonth
+		str         string
+		first, last Date
+	}{
+		{YearMonth{2024, time.January}, "2024-01", Date{2024, 1, 1}, Date{2024, 1, 31}},
+		{YearMonth{2024, time.February}, "2024-02", Date{2024, 2, 1}, Date{2024, 2, 29}},
+		{YearMonth{2023, time.February}, "2023-02", Date{2023, 2, 1}, Date{2023, 2, 28}},
+		{YearMonth{2024, time.April}, "2024-04", Date{2024, 4, 1}, Date{2024, 4, 30}},
+		{YearMonth{2024, time.December}, "2024-12", Date{2024, 12, 1}, Date{2024, 12, 31}},
+	} {
+		if got := test.ym.String(); got != test.str {
+			t.Errorf("%#v.String() = %q, want %q", test.ym, got, test.str)
+		}
+		if got := test.ym.FirstDate(); got != test.first {
+			t.Errorf("%v.FirstDate() = %v, want %v", test.ym, got, test.first)
+		}
+		if got := test.ym.LastDate(); got != test.last {
+			t.Errorf("%v.LastDate() = %v, want %v", test.ym, got, test.last)
+		}
+		if !test.ym.IsValid() {
+			t.Errorf("%v.IsValid() = false", test.ym)
+		}
+	}
+	for _, ym := range []YearMonth{{2024, 0}, {2024, 13}} {
+		if ym.IsValid() {
+			t.Errorf("%#v.IsValid() = true", ym)
+		}
+	}
+}
+
+func TestYearMonthGrid(t *testing.T) {
+	for _, test := range []struct {
+		ym          YearMonth
+		weekStart   time.Weekday
+		rows        int
+		first, last Date
+	}{
+		{YearMonth{2015, time.February}, time.Sunday, 4, Date{2015, 2, 1}, Date{2015, 2, 28}},
+		{YearMonth{2021, time.February}, time.Monday, 4, Date{2021, 2, 1}, Date{2021, 2, 28}},
+		{YearMonth{2024, time.March}, time.Monday, 5, Date{2024, 2, 26}, Date{2024, 3, 31}},
+		{YearMonth{2024, time.March}, time.Sunday, 6, Date{2024, 2, 25}, Date{2024, 4, 6}},
+		{YearMonth{2024, time.September}, time.Monday, 6, Date{2024, 8, 26}, Date{2024, 10, 6}},
+		{YearMonth{2024, time.September}, time.Saturday, 5, Date{2024, 8, 31}, Date{2024, 10, 4}},
+	} {
+		grid := test.ym.Grid(test.weekStart)
+		if len(grid) != test.rows {
+			t.Errorf("%v.Grid(%v) has %d rows, want %d", test.ym, test.weekStart, len(grid), test.rows)
+			continue
+		}
+		if got := grid[0][0]; got != test.first {
+			t.Errorf("%v.Grid(%v) starts on %v, want %v", test.ym, test.weekStart, got, test.first)
+		}
+		if got := grid[len(grid)-1][6]; got != test.last {
+			t.Errorf("%v.Grid(%v) ends on %v, want %v", test.ym, test.weekStart, got, test.last)
+		}
+		want := test.first
+		for _, row := range grid {
+			if row[0].Weekday() != test.weekStart {
+				t.Errorf("%v.Grid(%v) has a row starting on %v", test.ym, test.weekStart, row[0].Weekday())
+			}
+			for _, d := range row {
+				if d != want {
+					t.Errorf("%v.Grid(%v) has %v, want consecutive %v", test.ym, test.weekStart, d, want)
+				}
+				want = d.AddDays(1)
+			}
+		}
+	}
+}